@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+data "devlake_bitbucketserver_connection" "bbserver" {
+  name = "bitbucket"
+}
+
+data "devlake_bitbucketserver_connection_scopes" "scopes" {
+  connection_id = data.devlake_bitbucketserver_connection.bbserver.id
+  search_term   = "PROJECT"
+}
+
+# every scope has to be part of a blueprint
+check "bitbucketserver_scopes" {
+  assert {
+    condition     = alltrue([for scope in data.devlake_bitbucketserver_connection_scopes.scopes.scopes : length(scope.blueprints) > 0])
+    error_message = "All bitbucket server scopes need to be part of a blueprint."
+  }
+}
@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+data "devlake_bitbucketserver_connections" "all" {}
+
+output "bitbucketserver_connection_names" {
+  value = data.devlake_bitbucketserver_connections.all.connections[*].name
+}
@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+data "devlake_github_connection" "by_name" {
+  name = "github"
+}
+
+output "github_connection_id" {
+  value = data.devlake_github_connection.by_name.id
+}
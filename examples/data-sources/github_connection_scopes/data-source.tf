@@ -0,0 +1,35 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+data "devlake_github_connection" "gh" {
+  name = "github"
+}
+
+data "devlake_github_connection_scopes" "scopes" {
+  connection_id = data.devlake_github_connection.gh.id
+}
+
+# every scope needs a scope config and has to be part of a blueprint
+check "github_scopes" {
+  assert {
+    condition     = alltrue([for scope in data.devlake_github_connection_scopes.scopes.scopes : scope.scope_config != null])
+    error_message = "All github scopes need a scope config."
+  }
+
+  assert {
+    condition     = alltrue([for scope in data.devlake_github_connection_scopes.scopes.scopes : length(scope.blueprints) > 0])
+    error_message = "All github scopes need to be part of a blueprint."
+  }
+}
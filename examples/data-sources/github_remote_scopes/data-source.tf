@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+data "devlake_github_connection" "gh" {
+  name = "github"
+}
+
+data "devlake_github_remote_scopes" "apache" {
+  connection_id = data.devlake_github_connection.gh.id
+  owner         = "apache"
+}
+
+# add every repository of the organization
+resource "devlake_github_connection_scope" "apache" {
+  for_each = { for repo in data.devlake_github_remote_scopes.apache.scopes : repo.full_name => repo }
+
+  connection_id = data.devlake_github_connection.gh.id
+  description   = each.value.description
+  full_name     = each.value.full_name
+  id            = each.value.github_id
+}
@@ -0,0 +1,32 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+data "devlake_pipelines" "last" {
+  blueprint_id = "1"
+  page_size    = 1
+}
+
+# the last pipeline of the blueprint succeeded within the last 24 hours
+check "last_pipeline" {
+  assert {
+    condition     = length(data.devlake_pipelines.last.pipelines) == 1 && data.devlake_pipelines.last.pipelines[0].status == "TASK_COMPLETED"
+    error_message = "The last pipeline of the blueprint did not succeed."
+  }
+
+  assert {
+    condition     = length(data.devlake_pipelines.last.pipelines) == 1 && timecmp(timeadd(coalesce(data.devlake_pipelines.last.pipelines[0].finished_at, "1970-01-01T00:00:00Z"), "24h"), plantimestamp()) > 0
+    error_message = "The last pipeline of the blueprint finished more than 24 hours ago."
+  }
+}
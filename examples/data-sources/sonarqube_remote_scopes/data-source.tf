@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_sonarqube_connection" "sonar" {
+  endpoint = "https://sonar.example.com/api/"
+  name     = "should_not_exist"
+  token    = "whatever"
+}
+
+data "devlake_sonarqube_remote_scopes" "all" {
+  connection_id = devlake_sonarqube_connection.sonar.id
+}
+
+# add every project of the sonarqube server
+resource "devlake_sonarqube_connection_scope" "all" {
+  for_each = { for project in data.devlake_sonarqube_remote_scopes.all.scopes : project.project_key => project }
+
+  id            = each.value.project_key
+  connection_id = devlake_sonarqube_connection.sonar.id
+  name          = each.value.name
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# azure devops connection can be imported by specifying the numeric identifier.
+terraform import devlake_azuredevops_connection.tfresourcename "1"
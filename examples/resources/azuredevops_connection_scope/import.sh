@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# azure devops connection scope can be imported by specifying the connection id and the repository id.
+terraform import devlake_azuredevops_connection_scope.scope "1,0d50ba13-f9ad-49b0-9b21-d29eda50ca33"
@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_azuredevops_connection" "azdo" {
+  name         = "should_not_exist"
+  organization = "devlake"
+  token        = "whatever"
+}
+
+resource "devlake_azuredevops_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_azuredevops_connection.azdo.id
+  name          = "conf"
+}
+
+resource "devlake_azuredevops_connection_scope" "scope" {
+  id              = "0d50ba13-f9ad-49b0-9b21-d29eda50ca33"
+  connection_id   = devlake_azuredevops_connection.azdo.id
+  name            = "repo"
+  project_id      = "project"
+  scope_config_id = devlake_azuredevops_connection_scopeconfig.scopeconf.id
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# azure devops connection scopeconfig can be imported by specifying the numeric identifier of the connection and the scopeconfig.
+terraform import devlake_azuredevops_connection_scopeconfig.scopeconf "1,1"
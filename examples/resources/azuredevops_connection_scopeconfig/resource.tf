@@ -0,0 +1,25 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_azuredevops_connection" "azdo" {
+  name         = "should_not_exist"
+  organization = "devlake"
+  token        = "whatever"
+}
+
+resource "devlake_azuredevops_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_azuredevops_connection.azdo.id
+  name          = "conf2"
+}
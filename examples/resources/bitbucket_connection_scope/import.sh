@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# bitbucket cloud connection scope can be imported by specifying the connection id and the identifier in the form of '<WORKSPACE>/<REPO>'.
+terraform import devlake_bitbucket_connection_scope.scope "1,workspace/repo"
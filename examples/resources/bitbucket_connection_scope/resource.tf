@@ -0,0 +1,35 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_bitbucket_connection" "bbcloud" {
+  name     = "should_not_exist"
+  password = "whatever"
+  username = "serviceAccount"
+}
+
+resource "devlake_bitbucket_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_bitbucket_connection.bbcloud.id
+  name          = "conf"
+}
+
+resource "devlake_bitbucket_connection_scope" "scope" {
+  id              = "workspace/repo"
+  clone_url       = "https://bitbucket.org/workspace/repo.git"
+  connection_id   = devlake_bitbucket_connection.bbcloud.id
+  description     = "example repo"
+  html_url        = "https://bitbucket.org/workspace/repo"
+  name            = "workspace/repo"
+  scope_config_id = devlake_bitbucket_connection_scopeconfig.scopeconf.id
+}
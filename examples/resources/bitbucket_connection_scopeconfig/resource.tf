@@ -0,0 +1,25 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_bitbucket_connection" "bbcloud" {
+  name     = "should_not_exist"
+  password = "whatever"
+  username = "serviceAccount"
+}
+
+resource "devlake_bitbucket_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_bitbucket_connection.bbcloud.id
+  name          = "conf2"
+}
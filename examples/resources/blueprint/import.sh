@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# blueprint can be imported by specifying the numeric identifier.
+terraform import devlake_blueprint.bp "1"
@@ -32,3 +32,11 @@ resource "devlake_github_connection_scope" "scope" {
   description     = "example repo"
   scope_config_id = devlake_github_connection_scopeconfig.scopeconf.id
 }
+
+# the id is resolved from the full name via the remote scopes of the
+# connection, which requires the connection to have access to the repository
+resource "devlake_github_connection_scope" "resolved" {
+  full_name       = "apache/incubator-devlake"
+  connection_id   = devlake_github_connection.gh.id
+  scope_config_id = devlake_github_connection_scopeconfig.scopeconf.id
+}
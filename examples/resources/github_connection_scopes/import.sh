@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# github connection scopes can be imported by specifying the connection id, all scopes of the connection are managed afterwards.
+terraform import devlake_github_connection_scopes.scopes "1"
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# gitlab connection can be imported by specifying the numeric identifier.
+terraform import devlake_gitlab_connection.tfresourcename "1"
@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_gitlab_connection" "gl" {
+  endpoint = "https://gitlab.example.org/api/v4/"
+  name     = "should_not_exist"
+  token    = "glpat-whatever"
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# gitlab connection scope can be imported by specifying the connection id and the gitlab project identifier.
+terraform import devlake_gitlab_connection_scope.scope "1,42"
@@ -0,0 +1,35 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_gitlab_connection" "gl" {
+  endpoint = "https://gitlab.example.org/api/v4/"
+  name     = "should_not_exist"
+  token    = "glpat-whatever"
+}
+
+resource "devlake_gitlab_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_gitlab_connection.gl.id
+  name          = "conf1"
+}
+
+resource "devlake_gitlab_connection_scope" "scope" {
+  id                  = "42"
+  connection_id       = devlake_gitlab_connection.gl.id
+  description         = "example repo"
+  http_url_to_repo    = "https://gitlab.example.org/group/repo.git"
+  path_with_namespace = "group/repo"
+  scope_config_id     = devlake_gitlab_connection_scopeconfig.scopeconf.id
+  web_url             = "https://gitlab.example.org/group/repo"
+}
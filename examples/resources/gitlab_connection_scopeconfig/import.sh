@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# gitlab connection scopeconfig can be imported by specifying the numeric identifier of the connection and the scopeconfig.
+terraform import devlake_gitlab_connection_scopeconfig.scopeconf "1,1"
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# jenkins connection scope can be imported by specifying the connection id and the full name of the job including its folders.
+terraform import devlake_jenkins_connection_scope.scope "1,folder/deploy"
@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_jenkins_connection" "jenkins" {
+  endpoint = "https://jenkins.example.com/"
+  name     = "should_not_exist"
+  password = "whatever"
+  username = "serviceAccount"
+}
+
+resource "devlake_jenkins_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_jenkins_connection.jenkins.id
+  name          = "conf"
+}
+
+resource "devlake_jenkins_connection_scope" "scope" {
+  id              = "folder/deploy"
+  connection_id   = devlake_jenkins_connection.jenkins.id
+  description     = "example job"
+  scope_config_id = devlake_jenkins_connection_scopeconfig.scopeconf.id
+}
@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_jenkins_connection" "jenkins" {
+  endpoint = "https://jenkins.example.com/"
+  name     = "should_not_exist"
+  password = "whatever"
+  username = "serviceAccount"
+}
+
+resource "devlake_jenkins_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_jenkins_connection.jenkins.id
+  name          = "conf2"
+}
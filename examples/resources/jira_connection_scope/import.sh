@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# jira connection scope can be imported by specifying the connection id and the jira board identifier.
+terraform import devlake_jira_connection_scope.scope "1,42"
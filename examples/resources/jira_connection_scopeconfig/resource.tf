@@ -0,0 +1,39 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_jira_connection" "jira" {
+  endpoint = "https://your-domain.atlassian.net/rest/"
+  name     = "should_not_exist"
+  password = "whatever"
+  username = "serviceAccount"
+}
+
+resource "devlake_jira_connection_scopeconfig" "scopeconf" {
+  connection_id     = devlake_jira_connection.jira.id
+  name              = "conf1"
+  story_point_field = "customfield_10024"
+  type_mappings = {
+    Bug = {
+      standard_type = "BUG"
+      status_mappings = {
+        Open   = "TODO"
+        Closed = "DONE"
+      }
+    }
+    Story = {
+      standard_type = "REQUIREMENT"
+    }
+  }
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# opsgenie connection scope can be imported by specifying the connection id and the service id.
+terraform import devlake_opsgenie_connection_scope.scope "1,e0a0e9fb-6d5b-4f64-9f1a-8f3e1d6a3c52"
@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_opsgenie_connection" "og" {
+  name  = "should_not_exist"
+  token = "whatever"
+}
+
+resource "devlake_opsgenie_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_opsgenie_connection.og.id
+  name          = "conf"
+}
+
+resource "devlake_opsgenie_connection_scope" "scope" {
+  id              = "e0a0e9fb-6d5b-4f64-9f1a-8f3e1d6a3c52"
+  connection_id   = devlake_opsgenie_connection.og.id
+  name            = "devlake"
+  scope_config_id = devlake_opsgenie_connection_scopeconfig.scopeconf.id
+}
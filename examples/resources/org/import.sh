@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# org can be imported by specifying the fixed identifier "org".
+terraform import devlake_org.org "org"
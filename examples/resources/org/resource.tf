@@ -0,0 +1,44 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_org" "org" {
+  teams = [
+    {
+      id   = "1"
+      name = "Platform"
+    },
+    {
+      id         = "2"
+      alias      = "core"
+      name       = "Core"
+      parent_id  = "1"
+      sort_index = 1
+    },
+  ]
+  users = [
+    {
+      id       = "1"
+      email    = "jane@example.com"
+      name     = "Jane"
+      team_ids = ["2"]
+    },
+  ]
+  user_account_mappings = [
+    {
+      account_id = "github:GithubAccount:1:123"
+      user_id    = "1"
+    },
+  ]
+}
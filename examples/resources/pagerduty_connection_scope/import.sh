@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# pagerduty connection scope can be imported by specifying the connection id and the service id.
+terraform import devlake_pagerduty_connection_scope.scope "1,PIJ90N7"
@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_pagerduty_connection" "pd" {
+  name  = "should_not_exist"
+  token = "whatever"
+}
+
+resource "devlake_pagerduty_connection_scopeconfig" "scopeconf" {
+  connection_id = devlake_pagerduty_connection.pd.id
+  name          = "conf2"
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# project can be imported by specifying the project name.
+terraform import devlake_project.project "my-project"
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_project" "project" {
+  name        = "my-project"
+  description = "example project"
+  metrics = {
+    dora = {
+      enable = true
+    }
+    linker = {
+      enable        = true
+      plugin_option = jsonencode({
+        prToIssueRegexp = "(?mi)(Closes|Fixes)[\\s]*.*(((and )?#\\d+[ ]*)+)"
+      })
+    }
+  }
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# sonarqube connection can be imported by specifying the numeric identifier.
+terraform import devlake_sonarqube_connection.tfresourcename "1"
@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_sonarqube_connection" "tfresourcename" {
+  endpoint = "https://sonar.example.com/api/"
+  name     = "should_not_exist"
+  token    = "whatever"
+}
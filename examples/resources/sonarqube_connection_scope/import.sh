@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# sonarqube connection scope can be imported by specifying the connection id and the project key.
+terraform import devlake_sonarqube_connection_scope.scope "1,devlake"
@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_sonarqube_connection" "sonar" {
+  endpoint = "https://sonar.example.com/api/"
+  name     = "should_not_exist"
+  token    = "whatever"
+}
+
+resource "devlake_sonarqube_connection_scope" "scope" {
+  id            = "devlake"
+  connection_id = devlake_sonarqube_connection.sonar.id
+  name          = "DevLake"
+}
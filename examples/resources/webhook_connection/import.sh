@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+
+
+# webhook connection can be imported by specifying the numeric identifier, the api key is not known afterwards.
+terraform import devlake_webhook_connection.deploys "1"
@@ -0,0 +1,27 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform {
+  required_providers {
+    devlake = {
+      source = "registry.terraform.io/incubator-devlake-terraform/devlake"
+    }
+  }
+}
+
+provider "devlake" {
+  host  = "http://localhost:4000/api"
+  token = "whatever"
+}
+
+resource "devlake_webhook_connection" "deploys" {
+  name = "deploy-tools"
+}
+
+output "deployments_url" {
+  value = devlake_webhook_connection.deploys.deployments_url
+}
+
+output "webhook_api_key" {
+  value     = devlake_webhook_connection.deploys.api_key
+  sensitive = true
+}
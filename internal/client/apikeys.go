@@ -3,21 +3,24 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
 // CreateApiKey - Creates new apikey.
-func (c *Client) CreateApiKey(apiKeyCreate ApiKeyCreate) (*ApiKey, error) {
+func (c *Client) CreateApiKey(ctx context.Context, apiKeyCreate ApiKeyCreate) (*ApiKey, error) {
 	rb, err := json.Marshal(apiKeyCreate)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api-keys", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api-keys", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -37,34 +40,35 @@ func (c *Client) CreateApiKey(apiKeyCreate ApiKeyCreate) (*ApiKey, error) {
 	return &apiKey, nil
 }
 
-// ReadApiKeys - Returns list of apikeys.
-func (c *Client) ReadApiKeys() ([]ApiKey, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api-keys", c.HostURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// apiKeysPageSize - Page size used when listing the apikeys.
+const apiKeysPageSize = 100
 
+// ReadApiKeys - Returns list of apikeys, all pages are read so a missing key
+// really does not exist.
+func (c *Client) ReadApiKeys(ctx context.Context) ([]ApiKey, error) {
 	apiKeys := []ApiKey{}
-	err = json.Unmarshal(body, &struct {
-		ApiKeys *[]ApiKey `json:"apikeys"`
-	}{
-		ApiKeys: &apiKeys,
-	})
-	if err != nil {
-		return nil, err
-	}
+	query := url.Values{}
+	query.Set("pageSize", strconv.Itoa(apiKeysPageSize))
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		res, err := read[struct {
+			ApiKeys []ApiKey `json:"apikeys"`
+			Count   int      `json:"count"`
+		}](ctx, c, fmt.Sprintf("%s/api-keys?%s", c.HostURL, query.Encode()))
+		if err != nil {
+			return nil, err
+		}
 
-	return apiKeys, nil
+		apiKeys = append(apiKeys, res.ApiKeys...)
+		if len(res.ApiKeys) == 0 || len(apiKeys) >= res.Count {
+			return apiKeys, nil
+		}
+	}
 }
 
 // DeleteApiKey - Deletes an apikey.
-func (c *Client) DeleteApiKey(id string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api-keys/%s", c.HostURL, id), nil)
+func (c *Client) DeleteApiKey(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api-keys/%s", c.HostURL, id), nil)
 	if err != nil {
 		return err
 	}
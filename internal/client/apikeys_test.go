@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestReadApiKeysPages(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api-keys" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if pageSize := r.URL.Query().Get("pageSize"); pageSize != "100" {
+			t.Errorf("got pageSize %q, want 100", pageSize)
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"count":3,"apikeys":[{"id":1},{"id":2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"count":3,"apikeys":[{"id":3}]}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	apiKeys, err := c.ReadApiKeys(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(apiKeys) != 3 || apiKeys[2].ID != 3 {
+		t.Errorf("unexpected apikeys %+v", apiKeys)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"net/http"
+)
+
+// Auth - Adds the credentials to a request to the devlake api.
+type Auth interface {
+	Apply(req *http.Request)
+}
+
+// BearerAuth - Authenticates with a devlake api key, e.g. one created with
+// the devlake_apikey resource.
+type BearerAuth struct {
+	Token string
+}
+
+// Apply - Sends the api key using the Bearer scheme.
+func (a BearerAuth) Apply(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+}
+
+// BasicAuth - Authenticates with http basic auth, e.g. against the nginx of
+// the devlake config-ui.
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Apply - Sends the username and password using the Basic scheme.
+func (a BasicAuth) Apply(req *http.Request) {
+	req.SetBasicAuth(a.Username, a.Password)
+}
+
+// NoAuth - Sends requests without credentials, e.g. to a local devlake.
+type NoAuth struct{}
+
+// Apply - Leaves the request untouched.
+func (NoAuth) Apply(*http.Request) {}
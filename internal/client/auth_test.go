@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRequestAuth(t *testing.T) {
+	tests := map[string]struct {
+		auth     Auth
+		wantAuth string
+	}{
+		"bearer api key": {
+			auth:     BearerAuth{Token: "secret"},
+			wantAuth: "Bearer secret",
+		},
+		"basic auth": {
+			auth: BasicAuth{Username: "admin", Password: "admin"},
+			// base64 of admin:admin
+			wantAuth: "Basic YWRtaW46YWRtaW4=",
+		},
+		"no auth": {
+			auth:     NoAuth{},
+			wantAuth: "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != tt.wantAuth {
+					t.Errorf("Authorization: got %q, want %q", got, tt.wantAuth)
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			t.Cleanup(server.Close)
+
+			host := server.URL
+			c, err := NewClient(&host, tt.auth)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			if _, err := read[struct{}](t.Context(), c, c.HostURL+"/projects/p"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestNewClientWithoutAuth(t *testing.T) {
+	if _, err := NewClient(nil, nil); err == nil {
+		t.Error("expected an error without auth")
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateAzureDevopsConnection - Creates new azure devops connection.
+func (c *Client) CreateAzureDevopsConnection(ctx context.Context, connection AzureDevopsConnection) (*AzureDevopsConnection, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadAzureDevopsConnection - Returns azure devops connection.
+func (c *Client) ReadAzureDevopsConnection(ctx context.Context, id string) (*AzureDevopsConnection, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s", c.HostURL, id)
+	return read[AzureDevopsConnection](ctx, c, url)
+}
+
+// UpdateAzureDevopsConnection - Updates azure devops connection.
+func (c *Client) UpdateAzureDevopsConnection(ctx context.Context, id string, connection AzureDevopsConnection) (*AzureDevopsConnection, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteAzureDevopsConnection - Deletes an azure devops connection.
+func (c *Client) DeleteAzureDevopsConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE CONFIG
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateAzureDevopsConnectionScopeConfig - Creates an azure devops connection scope config.
+func (c *Client) CreateAzureDevopsConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig AzureDevopsConnectionScopeConfig) (*AzureDevopsConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scope-configs", c.HostURL, connectionId)
+	return create(ctx, c, url, scopeConfig)
+}
+
+// ReadAzureDevopsConnectionScopeConfig - Reads an azure devops connection scope config.
+func (c *Client) ReadAzureDevopsConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*AzureDevopsConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return read[AzureDevopsConnectionScopeConfig](ctx, c, url)
+}
+
+// UpdateAzureDevopsConnectionScopeConfig - Updates an azure devops connection scope config.
+func (c *Client) UpdateAzureDevopsConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig AzureDevopsConnectionScopeConfig) (*AzureDevopsConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteAzureDevopsConnectionScopeConfig - Deletes an azure devops connection scope config.
+func (c *Client) DeleteAzureDevopsConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateAzureDevopsConnectionScope - Creates an azure devops connection scope.
+func (c *Client) CreateAzureDevopsConnectionScope(ctx context.Context, connectionId string, scope AzureDevopsConnectionScope) (*AzureDevopsConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadAzureDevopsConnectionScope - Reads an azure devops connection scope.
+func (c *Client) ReadAzureDevopsConnectionScope(ctx context.Context, connectionId, scopeId string) (*AzureDevopsConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[AzureDevopsConnectionScope](ctx, c, url)
+}
+
+// UpdateAzureDevopsConnectionScope - Updates an azure devops connection scope.
+func (c *Client) UpdateAzureDevopsConnectionScope(ctx context.Context, connectionId, scopeId string, scope AzureDevopsConnectionScope) (*AzureDevopsConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return update(ctx, c, url, scope)
+}
+
+// DeleteAzureDevopsConnectionScope - Deletes an azure devops connection scope.
+func (c *Client) DeleteAzureDevopsConnectionScope(ctx context.Context, connectionId, scopeId string) error {
+	url := fmt.Sprintf("%s/plugins/azuredevops_go/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return del(ctx, c, url)
+}
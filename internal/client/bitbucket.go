@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateBitbucketConnection - Creates new bitbucket cloud connection.
+func (c *Client) CreateBitbucketConnection(ctx context.Context, connection BitbucketConnection) (*BitbucketConnection, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadBitbucketConnection - Returns bitbucket cloud connection.
+func (c *Client) ReadBitbucketConnection(ctx context.Context, id string) (*BitbucketConnection, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s", c.HostURL, id)
+	return read[BitbucketConnection](ctx, c, url)
+}
+
+// UpdateBitbucketConnection - Updates bitbucket cloud connection.
+func (c *Client) UpdateBitbucketConnection(ctx context.Context, id string, connection BitbucketConnection) (*BitbucketConnection, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteBitbucketConnection - Deletes a bitbucket cloud connection.
+func (c *Client) DeleteBitbucketConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE CONFIG
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateBitbucketConnectionScopeConfig - Creates a bitbucket cloud connection scope config.
+func (c *Client) CreateBitbucketConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig BitbucketConnectionScopeConfig) (*BitbucketConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scope-configs", c.HostURL, connectionId)
+	return create(ctx, c, url, scopeConfig)
+}
+
+// ReadBitbucketConnectionScopeConfig - Reads a bitbucket cloud connection scope config.
+func (c *Client) ReadBitbucketConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*BitbucketConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return read[BitbucketConnectionScopeConfig](ctx, c, url)
+}
+
+// UpdateBitbucketConnectionScopeConfig - Updates a bitbucket cloud connection scope config.
+func (c *Client) UpdateBitbucketConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig BitbucketConnectionScopeConfig) (*BitbucketConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteBitbucketConnectionScopeConfig - Deletes a bitbucket cloud connection scope config.
+func (c *Client) DeleteBitbucketConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateBitbucketConnectionScope - Creates a bitbucket cloud connection scope.
+func (c *Client) CreateBitbucketConnectionScope(ctx context.Context, connectionId string, scope BitbucketConnectionScope) (*BitbucketConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadBitbucketConnectionScope - Reads a bitbucket cloud connection scope.
+func (c *Client) ReadBitbucketConnectionScope(ctx context.Context, connectionId, scopeId string) (*BitbucketConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[BitbucketConnectionScope](ctx, c, url)
+}
+
+// UpdateBitbucketConnectionScope - Updates a bitbucket cloud connection scope.
+func (c *Client) UpdateBitbucketConnectionScope(ctx context.Context, connectionId, scopeId string, scope BitbucketConnectionScope) (*BitbucketConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return update(ctx, c, url, scope)
+}
+
+// DeleteBitbucketConnectionScope - Deletes a bitbucket cloud connection scope.
+func (c *Client) DeleteBitbucketConnectionScope(ctx context.Context, connectionId, scopeId string) error {
+	url := fmt.Sprintf("%s/plugins/bitbucket/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return del(ctx, c, url)
+}
@@ -3,10 +3,8 @@
 package client
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
-	"strings"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -14,27 +12,37 @@ import (
 ////////////////////////////////////////////////////////////////////////////////
 
 // CreateBitbucketServerConnection - Creates new bitbucket server connection.
-func (c *Client) CreateBitbucketServerConnection(connection BitbucketServerConnection) (*BitbucketServerConnection, error) {
+func (c *Client) CreateBitbucketServerConnection(ctx context.Context, connection BitbucketServerConnection) (*BitbucketServerConnection, error) {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections", c.HostURL)
-	return create(c, url, connection)
+	return create(ctx, c, url, connection)
+}
+
+// ListBitbucketServerConnections - Returns all bitbucket server connections.
+func (c *Client) ListBitbucketServerConnections(ctx context.Context) ([]BitbucketServerConnection, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections", c.HostURL)
+	connections, err := read[[]BitbucketServerConnection](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+	return *connections, nil
 }
 
 // ReadBitbucketServerConnection - Returns bitbucket server connection.
-func (c *Client) ReadBitbucketServerConnection(id string) (*BitbucketServerConnection, error) {
+func (c *Client) ReadBitbucketServerConnection(ctx context.Context, id string) (*BitbucketServerConnection, error) {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s", c.HostURL, id)
-	return read[BitbucketServerConnection](c, url)
+	return read[BitbucketServerConnection](ctx, c, url)
 }
 
 // UpdateBitbucketServerConnection - Updates bitbucket server connection.
-func (c *Client) UpdateBitbucketServerConnection(id string, connection BitbucketServerConnection) (*BitbucketServerConnection, error) {
+func (c *Client) UpdateBitbucketServerConnection(ctx context.Context, id string, connection BitbucketServerConnection) (*BitbucketServerConnection, error) {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s", c.HostURL, id)
-	return update(c, url, connection)
+	return update(ctx, c, url, connection)
 }
 
 // DeleteBitbucketServerConnection - Deletes a bitbucket server connection.
-func (c *Client) DeleteBitbucketServerConnection(id string) error {
+func (c *Client) DeleteBitbucketServerConnection(ctx context.Context, id string) error {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s", c.HostURL, id)
-	return del(c, url)
+	return del(ctx, c, url)
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -42,27 +50,27 @@ func (c *Client) DeleteBitbucketServerConnection(id string) error {
 ////////////////////////////////////////////////////////////////////////////////
 
 // CreateBitbucketServerConnectionScopeConfig - Creates a bitbucket server connection scope config.
-func (c *Client) CreateBitbucketServerConnectionScopeConfig(connectionId string, scopeConfig BitbucketServerConnectionScopeConfig) (*BitbucketServerConnectionScopeConfig, error) {
+func (c *Client) CreateBitbucketServerConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig BitbucketServerConnectionScopeConfig) (*BitbucketServerConnectionScopeConfig, error) {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scope-configs", c.HostURL, connectionId)
-	return create(c, url, scopeConfig)
+	return create(ctx, c, url, scopeConfig)
 }
 
 // ReadBitbucketServerConnectionScopeConfig - Reads a bitbucket server connection scope config.
-func (c *Client) ReadBitbucketServerConnectionScopeConfig(connectionId, scopeConfigId string) (*BitbucketServerConnectionScopeConfig, error) {
+func (c *Client) ReadBitbucketServerConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*BitbucketServerConnectionScopeConfig, error) {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
-	return read[BitbucketServerConnectionScopeConfig](c, url)
+	return read[BitbucketServerConnectionScopeConfig](ctx, c, url)
 }
 
 // UpdateBitbucketServerConnectionScopeConfig - Updates a bitbucket server connection scope config.
-func (c *Client) UpdateBitbucketServerConnectionScopeConfig(connectionId, scopeConfigId string, scopeConfig BitbucketServerConnectionScopeConfig) (*BitbucketServerConnectionScopeConfig, error) {
+func (c *Client) UpdateBitbucketServerConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig BitbucketServerConnectionScopeConfig) (*BitbucketServerConnectionScopeConfig, error) {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
-	return update(c, url, scopeConfig)
+	return update(ctx, c, url, scopeConfig)
 }
 
 // DeleteBitbucketServerConnectionScopeConfig - Deletes a bitbucket server connection scope config.
-func (c *Client) DeleteBitbucketServerConnectionScopeConfig(connectionId, scopeConfigId string) error {
+func (c *Client) DeleteBitbucketServerConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
-	return del(c, url)
+	return del(ctx, c, url)
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -70,73 +78,46 @@ func (c *Client) DeleteBitbucketServerConnectionScopeConfig(connectionId, scopeC
 ////////////////////////////////////////////////////////////////////////////////
 
 // CreateBitbucketServerConnectionScope - Creates a bitbucket server connection scope.
-func (c *Client) CreateBitbucketServerConnectionScope(connectionId string, scope BitbucketServerConnectionScope) (*BitbucketServerConnectionScope, error) {
-	data := struct {
-		Data []BitbucketServerConnectionScope `json:"data"`
-	}{
-		Data: []BitbucketServerConnectionScope{scope},
-	}
-	rb, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes", c.HostURL, connectionId), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Add("content-type", "application/json")
+func (c *Client) CreateBitbucketServerConnectionScope(ctx context.Context, connectionId string, scope BitbucketServerConnectionScope) (*BitbucketServerConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// CreateBitbucketServerConnectionScopes - Creates or updates many bitbucket
+// server connection scopes.
+func (c *Client) CreateBitbucketServerConnectionScopes(ctx context.Context, connectionId string, scopes []BitbucketServerConnectionScope) ([]BitbucketServerConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes", c.HostURL, connectionId)
+	return createScopes(ctx, c, url, scopes)
+}
 
-	// endpoint accepts list but we only ever create one scope at a time, can
-	// not use generic function because of this
-	createdScopes := []BitbucketServerConnectionScope{}
-	err = json.Unmarshal(body, &createdScopes)
-	if err != nil {
-		return nil, err
-	}
+// ListBitbucketServerConnectionScopes - Lists all bitbucket server connection scopes.
+func (c *Client) ListBitbucketServerConnectionScopes(ctx context.Context, connectionId string) ([]BitbucketServerConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes", c.HostURL, connectionId)
+	return listScopes[BitbucketServerConnectionScope](ctx, c, url)
+}
 
-	return &createdScopes[0], nil
+// ListBitbucketServerConnectionScopeDetails - Lists the bitbucket server
+// connection scopes with their scope configs and the blueprints referencing
+// them, optionally filtered by a search term.
+func (c *Client) ListBitbucketServerConnectionScopeDetails(ctx context.Context, connectionId, searchTerm string) ([]ScopeDetail[BitbucketServerConnectionScope, BitbucketServerConnectionScopeConfig], error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes", c.HostURL, connectionId)
+	return listScopeDetails[BitbucketServerConnectionScope, BitbucketServerConnectionScopeConfig](ctx, c, url, searchTerm, true)
 }
 
 // ReadBitbucketServerConnectionScope - Reads a bitbucket server connection scope.
-func (c *Client) ReadBitbucketServerConnectionScope(connectionId, scopeId string) (*BitbucketServerConnectionScope, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Add("content-type", "application/json")
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	// can not use generic read since response also contains the scope config
-	res := struct {
-		Scope       BitbucketServerConnectionScope       `json:"scope"`
-		ScopeConfig BitbucketServerConnectionScopeConfig `json:"scopeConfig"`
-	}{}
-	err = json.Unmarshal(body, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Scope, nil
+func (c *Client) ReadBitbucketServerConnectionScope(ctx context.Context, connectionId, scopeId string) (*BitbucketServerConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[BitbucketServerConnectionScope](ctx, c, url)
 }
 
 // UpdateBitbucketServerConnectionScope - Updates a bitbucket server connection scope.
-func (c *Client) UpdateBitbucketServerConnectionScope(connectionId, scopeId string, scopeConfig BitbucketServerConnectionScope) (*BitbucketServerConnectionScope, error) {
+func (c *Client) UpdateBitbucketServerConnectionScope(ctx context.Context, connectionId, scopeId string, scopeConfig BitbucketServerConnectionScope) (*BitbucketServerConnectionScope, error) {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
-	return update(c, url, scopeConfig)
+	return update(ctx, c, url, scopeConfig)
 }
 
 // DeleteBitbucketServerConnectionScope - Deletes a bitbucket server connection scope.
-func (c *Client) DeleteBitbucketServerConnectionScope(connectionId, scopeId string) error {
+func (c *Client) DeleteBitbucketServerConnectionScope(ctx context.Context, connectionId, scopeId string) error {
 	url := fmt.Sprintf("%s/plugins/bitbucket_server/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
-	return del(c, url)
+	return del(ctx, c, url)
 }
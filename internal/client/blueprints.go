@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// CreateBlueprint - Creates new blueprint.
+func (c *Client) CreateBlueprint(ctx context.Context, blueprint Blueprint) (*Blueprint, error) {
+	url := fmt.Sprintf("%s/blueprints", c.HostURL)
+	return create(ctx, c, url, blueprint)
+}
+
+// ReadBlueprint - Returns blueprint.
+func (c *Client) ReadBlueprint(ctx context.Context, id string) (*Blueprint, error) {
+	url := fmt.Sprintf("%s/blueprints/%s", c.HostURL, id)
+	return read[Blueprint](ctx, c, url)
+}
+
+// UpdateBlueprint - Updates blueprint.
+func (c *Client) UpdateBlueprint(ctx context.Context, id string, blueprint Blueprint) (*Blueprint, error) {
+	url := fmt.Sprintf("%s/blueprints/%s", c.HostURL, id)
+	return update(ctx, c, url, blueprint)
+}
+
+// DeleteBlueprint - Deletes a blueprint.
+func (c *Client) DeleteBlueprint(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/blueprints/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+// TriggerBlueprint - Triggers a new pipeline run of a blueprint.
+func (c *Client) TriggerBlueprint(ctx context.Context, id string, trigger BlueprintTrigger) (*Pipeline, error) {
+	rb, err := json.Marshal(trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/blueprints/%s/trigger", c.HostURL, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := Pipeline{}
+	err = json.Unmarshal(body, &pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipeline, nil
+}
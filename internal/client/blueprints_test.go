@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateBlueprintClearsTimeAfter(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/blueprints/1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("unexpected error reading the body: %s", err)
+			return
+		}
+		// an unset time after has to be sent as null, otherwise devlake
+		// keeps the previous value
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			t.Errorf("unexpected error decoding the body: %s", err)
+			return
+		}
+		if timeAfter, ok := fields["timeAfter"]; !ok || string(timeAfter) != "null" {
+			t.Errorf("got timeAfter %q, want null", timeAfter)
+		}
+		fmt.Fprint(w, `{"id":1,"name":"bp"}`)
+	})
+
+	_, err := c.UpdateBlueprint(t.Context(), "1", Blueprint{Name: "bp"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -4,7 +4,6 @@ package client
 
 import (
 	"errors"
-	"fmt"
 	"io"
 	"net/http"
 	"time"
@@ -17,21 +16,33 @@ const HostURL string = "http://localhost:4000/api"
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
-	Token      string
+	Auth       Auth
+	// Headers are added to every request, they can not replace the
+	// Accept header and the credentials added by Auth.
+	Headers map[string]string
+	// MaxRetries is the number of times a failed request is retried, 0
+	// disables retries.
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
 }
 
-// NewClient - Create new client.
-func NewClient(host, token *string) (*Client, error) {
-	// If token not provided, return error
-	if token == nil {
-		return nil, errors.New("no api token provided")
+// NewClient - Create new client, use NoAuth for a devlake without
+// authentication.
+func NewClient(host *string, auth Auth) (*Client, error) {
+	// If auth not provided, return error
+	if auth == nil {
+		return nil, errors.New("no api authentication provided")
 	}
 
 	c := Client{
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
 		// Default Devlake URL
-		HostURL: HostURL,
-		Token:   *token,
+		HostURL:      HostURL,
+		Auth:         auth,
+		MaxRetries:   DefaultMaxRetries,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryWaitMax: DefaultRetryWaitMax,
 	}
 
 	if host != nil {
@@ -41,25 +52,52 @@ func NewClient(host, token *string) (*Client, error) {
 	return &c, nil
 }
 
-// doRequest - Query the devlake backend.
+// doRequest - Query the devlake backend, retrying failed requests with an
+// exponential backoff until the context of the request is done.
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	req.Header.Set("Authorization", c.Token)
+	for key, value := range c.Headers {
+		req.Header.Set(key, value)
+	}
+	c.Auth.Apply(req)
 	req.Header.Set("Accept", "application/json")
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			// the body was consumed by the previous attempt
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+		res, err := c.HTTPClient.Do(req)
+		if attempt < c.MaxRetries && req.Context().Err() == nil && shouldRetry(req.Method, res, err) {
+			if res != nil {
+				_, _ = io.Copy(io.Discard, res.Body)
+				res.Body.Close()
+			}
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(c.backoff(attempt+1, res)):
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
 
-	if res.StatusCode < http.StatusOK || res.StatusCode > http.StatusIMUsed {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
-	}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode < http.StatusOK || res.StatusCode > http.StatusIMUsed {
+			return nil, newApiError(res.StatusCode, body)
+		}
 
-	return body, err
+		return body, nil
+	}
 }
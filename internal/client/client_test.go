@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	host := server.URL
+	c, err := NewClient(&host, BearerAuth{Token: "whatever"})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	return c
+}
+
+func TestDoRequestApiError(t *testing.T) {
+	tests := map[string]struct {
+		status       int
+		body         string
+		wantMessage  string
+		wantCauses   []string
+		wantError    string
+		wantNotFound bool
+	}{
+		"not found with json body": {
+			status:       http.StatusNotFound,
+			body:         `{"success":false,"message":"could not find connection","causes":["record not found"]}`,
+			wantMessage:  "could not find connection",
+			wantCauses:   []string{"record not found"},
+			wantError:    "status: 404, message: could not find connection, causes: record not found",
+			wantNotFound: true,
+		},
+		"bad request without causes": {
+			status:      http.StatusBadRequest,
+			body:        `{"success":false,"message":"invalid input","data":{"field":"name"}}`,
+			wantMessage: "invalid input",
+			wantError:   "status: 400, message: invalid input",
+		},
+		"server error with plain body": {
+			status:    http.StatusInternalServerError,
+			body:      "upstream connect error",
+			wantError: "status: 500, body: upstream connect error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			_, err := read[struct{}](t.Context(), c, c.HostURL+"/plugins/github/connections/1")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			var apiErr *ApiError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an *ApiError, got %T", err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("status code: got %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.Message != tt.wantMessage {
+				t.Errorf("message: got %q, want %q", apiErr.Message, tt.wantMessage)
+			}
+			if fmt.Sprint(apiErr.Causes) != fmt.Sprint(tt.wantCauses) {
+				t.Errorf("causes: got %v, want %v", apiErr.Causes, tt.wantCauses)
+			}
+			if err.Error() != tt.wantError {
+				t.Errorf("error: got %q, want %q", err.Error(), tt.wantError)
+			}
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Errorf("IsNotFound: got %t, want %t", IsNotFound(err), tt.wantNotFound)
+			}
+		})
+	}
+}
+
+func TestIsNotFoundWrapped(t *testing.T) {
+	err := fmt.Errorf("reading scope: %w", &ApiError{StatusCode: http.StatusNotFound})
+	if !IsNotFound(err) {
+		t.Error("expected wrapped 404 to be reported as not found")
+	}
+	if IsNotFound(errors.New("status: 404")) {
+		t.Error("expected plain error not to be reported as not found")
+	}
+}
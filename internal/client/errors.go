@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ApiError - Error response of the devlake api.
+type ApiError struct {
+	StatusCode int
+	Message    string
+	Causes     []string
+	Body       string
+}
+
+// Error - Formats the error including the devlake message and causes if the
+// response body could be parsed, otherwise the raw body.
+func (e *ApiError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+	}
+	if len(e.Causes) == 0 {
+		return fmt.Sprintf("status: %d, message: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("status: %d, message: %s, causes: %s", e.StatusCode, e.Message, strings.Join(e.Causes, "; "))
+}
+
+// newApiError - Creates an ApiError from a non successful response.
+func newApiError(statusCode int, body []byte) *ApiError {
+	apiErr := ApiError{
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+
+	// the data of the JsonBody varies, only the message and causes are of
+	// interest here
+	jsonBody := struct {
+		Causes  []string `json:"causes"`
+		Message string   `json:"message"`
+	}{}
+	if err := json.Unmarshal(body, &jsonBody); err == nil {
+		apiErr.Message = jsonBody.Message
+		apiErr.Causes = jsonBody.Causes
+	}
+
+	return &apiErr
+}
+
+// IsNotFound - Reports whether err is an ApiError for a missing object.
+func IsNotFound(err error) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
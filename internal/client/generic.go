@@ -3,19 +3,28 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
 	"strings"
 )
 
 // create - Generic wrapper for POST requests.
-func create[T any](c *Client, url string, reqObj T) (*T, error) {
+func create[T any](ctx context.Context, c *Client, url string, reqObj T) (*T, error) {
 	rb, err := json.Marshal(reqObj)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", url, strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -36,8 +45,8 @@ func create[T any](c *Client, url string, reqObj T) (*T, error) {
 }
 
 // read - Generic wrapper for GET requests.
-func read[T any](c *Client, url string) (*T, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func read[T any](ctx context.Context, c *Client, url string) (*T, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,13 +66,13 @@ func read[T any](c *Client, url string) (*T, error) {
 }
 
 // update - Generic wrapper for PATCH requests.
-func update[T any](c *Client, url string, reqObj T) (*T, error) {
+func update[T any](ctx context.Context, c *Client, url string, reqObj T) (*T, error) {
 	rb, err := json.Marshal(reqObj)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", url, strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -84,8 +93,8 @@ func update[T any](c *Client, url string, reqObj T) (*T, error) {
 }
 
 // delete - Generic wrapper for DELETE requests.
-func del(c *Client, url string) error {
-	req, err := http.NewRequest("DELETE", url, nil)
+func del(ctx context.Context, c *Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
@@ -97,3 +106,255 @@ func del(c *Client, url string) error {
 
 	return nil
 }
+
+// readCsv - Generic wrapper for GET requests downloading a csv file. The rows
+// are returned keyed by the column names of the header.
+func readCsv(ctx context.Context, c *Client, url string) ([]map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(body))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []map[string]string{}
+	if len(records) == 0 {
+		return rows, nil
+	}
+	header := records[0]
+	for _, record := range records[1:] {
+		row := map[string]string{}
+		for i, value := range record {
+			if i < len(header) {
+				row[strings.TrimSpace(header[i])] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// uploadCsv - Generic wrapper for the multipart PUT requests uploading a csv
+// file, devlake replaces all existing records with the uploaded ones.
+func uploadCsv(ctx context.Context, c *Client, url string, header []string, records [][]string) error {
+	var file bytes.Buffer
+	writer := csv.NewWriter(&file)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", path.Base(url))
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(file.Bytes()); err != nil {
+		return err
+	}
+	if err := form.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("content-type", form.FormDataContentType())
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// scopesBatchSize - Number of scopes created with a single request.
+const scopesBatchSize = 100
+
+// createScope - Generic wrapper for the PUT requests creating plugin scopes.
+// The endpoint accepts a list but we only ever create one scope at a time.
+func createScope[T any](ctx context.Context, c *Client, url string, scope T) (*T, error) {
+	createdScopes, err := createScopes(ctx, c, url, []T{scope})
+	if err != nil {
+		return nil, err
+	}
+	if len(createdScopes) == 0 {
+		return nil, errors.New("devlake did not return the created scope")
+	}
+
+	return &createdScopes[0], nil
+}
+
+// createScopes - Generic wrapper for the PUT requests creating or updating
+// many plugin scopes, they are sent in batches of scopesBatchSize.
+func createScopes[T any](ctx context.Context, c *Client, url string, scopes []T) ([]T, error) {
+	createdScopes := []T{}
+	for batch := range slices.Chunk(scopes, scopesBatchSize) {
+		data := struct {
+			Data []T `json:"data"`
+		}{
+			Data: batch,
+		}
+		rb, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, strings.NewReader(string(rb)))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("content-type", "application/json")
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		batchScopes := []T{}
+		err = json.Unmarshal(body, &batchScopes)
+		if err != nil {
+			return nil, err
+		}
+		createdScopes = append(createdScopes, batchScopes...)
+	}
+
+	return createdScopes, nil
+}
+
+// readScope - Generic wrapper for GET requests of plugin scopes. The response
+// also contains the scope config, which is discarded.
+func readScope[T any](ctx context.Context, c *Client, url string) (*T, error) {
+	res, err := read[struct {
+		Scope T `json:"scope"`
+	}](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Scope, nil
+}
+
+// scopesPageSize - Page size used when listing the scopes of a connection.
+const scopesPageSize = 100
+
+// listScopes - Generic wrapper for the paginated GET requests listing the
+// scopes of a connection. The scope configs in the response are discarded.
+func listScopes[T any](ctx context.Context, c *Client, endpoint string) ([]T, error) {
+	details, err := listScopeDetails[T, json.RawMessage](ctx, c, endpoint, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := []T{}
+	for _, detail := range details {
+		scopes = append(scopes, detail.Scope)
+	}
+	return scopes, nil
+}
+
+// listScopeDetails - Generic wrapper for the paginated GET requests listing
+// the scopes of a connection together with their scope configs, optionally
+// filtered by searchTerm and with the blueprints referencing each scope.
+func listScopeDetails[T, C any](ctx context.Context, c *Client, endpoint, searchTerm string, blueprints bool) ([]ScopeDetail[T, C], error) {
+	details := []ScopeDetail[T, C]{}
+	query := url.Values{}
+	query.Set("pageSize", strconv.Itoa(scopesPageSize))
+	if searchTerm != "" {
+		query.Set("searchTerm", searchTerm)
+	}
+	if blueprints {
+		query.Set("blueprints", "true")
+	}
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		res, err := read[struct {
+			Count  int                 `json:"count"`
+			Scopes []ScopeDetail[T, C] `json:"scopes"`
+		}](ctx, c, endpoint+"?"+query.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		details = append(details, res.Scopes...)
+		if len(res.Scopes) == 0 || len(details) >= res.Count {
+			return details, nil
+		}
+	}
+}
+
+// remoteScopesPageSize - Page size used when searching remote scopes.
+const remoteScopesPageSize = 100
+
+// listRemoteScopes - Generic wrapper for the paginated GET requests listing
+// the remote scopes of a group, e.g. the repositories of a github
+// organization. Subgroups are skipped, only the scopes are returned.
+func listRemoteScopes[T any](ctx context.Context, c *Client, endpoint, groupId string) ([]T, error) {
+	scopes := []T{}
+	query := url.Values{}
+	query.Set("groupId", groupId)
+	for {
+		res, err := read[struct {
+			Children      []RemoteScope[T] `json:"children"`
+			NextPageToken string           `json:"nextPageToken"`
+		}](ctx, c, endpoint+"?"+query.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		scopes = appendRemoteScopes(scopes, res.Children)
+		if res.NextPageToken == "" {
+			return scopes, nil
+		}
+		query.Set("pageToken", res.NextPageToken)
+	}
+}
+
+// searchRemoteScopes - Generic wrapper for the paginated GET requests
+// searching remote scopes by name.
+func searchRemoteScopes[T any](ctx context.Context, c *Client, endpoint, search string) ([]T, error) {
+	scopes := []T{}
+	query := url.Values{}
+	query.Set("search", search)
+	query.Set("pageSize", strconv.Itoa(remoteScopesPageSize))
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		res, err := read[struct {
+			Children []RemoteScope[T] `json:"children"`
+		}](ctx, c, endpoint+"?"+query.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		scopes = appendRemoteScopes(scopes, res.Children)
+		if len(res.Children) < remoteScopesPageSize {
+			return scopes, nil
+		}
+	}
+}
+
+// appendRemoteScopes - Appends the data of the scope entries to scopes.
+func appendRemoteScopes[T any](scopes []T, children []RemoteScope[T]) []T {
+	for _, child := range children {
+		if child.Type == "scope" && child.Data != nil {
+			scopes = append(scopes, *child.Data)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListRemoteScopesPagination(t *testing.T) {
+	var queries []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("pageToken") == "" {
+			fmt.Fprint(w, `{"children":[
+				{"type":"group","id":"apache/sub","name":"sub"},
+				{"type":"scope","id":"1","name":"a","data":{"GithubId":1,"FullName":"apache/a"}}
+			],"nextPageToken":"next"}`)
+			return
+		}
+		fmt.Fprint(w, `{"children":[{"type":"scope","id":"2","name":"b","data":{"GithubId":2,"FullName":"apache/b"}}]}`)
+	})
+
+	scopes, err := c.ListGithubRemoteScopes(t.Context(), "1", "apache")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(scopes) != 2 || scopes[0].FullName != "apache/a" || scopes[1].GithubId != 2 {
+		t.Errorf("unexpected scopes %+v", scopes)
+	}
+	if want := []string{"groupId=apache", "groupId=apache&pageToken=next"}; fmt.Sprint(queries) != fmt.Sprint(want) {
+		t.Errorf("got queries %v, want %v", queries, want)
+	}
+}
+
+func TestSearchRemoteScopesPagination(t *testing.T) {
+	var pages []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		if r.URL.Query().Get("search") != "devlake" {
+			t.Errorf("unexpected search %q", r.URL.Query().Get("search"))
+		}
+
+		count := remoteScopesPageSize
+		if r.URL.Query().Get("page") == "2" {
+			count = 1
+		}
+		children := make([]string, count)
+		for i := range children {
+			children[i] = `{"type":"scope","data":{"FullName":"apache/devlake"}}`
+		}
+		fmt.Fprintf(w, `{"children":[%s],"page":1,"pageSize":%d}`, strings.Join(children, ","), remoteScopesPageSize)
+	})
+
+	scopes, err := c.SearchGithubRemoteScopes(t.Context(), "1", "devlake")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(scopes) != remoteScopesPageSize+1 {
+		t.Errorf("got %d scopes, want %d", len(scopes), remoteScopesPageSize+1)
+	}
+	if fmt.Sprint(pages) != "[1 2]" {
+		t.Errorf("got pages %v, want [1 2]", pages)
+	}
+}
+
+func TestCreateScopesBatches(t *testing.T) {
+	var batches []int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %s", err)
+		}
+		batches = append(batches, len(body.Data))
+		rb, _ := json.Marshal(body.Data)
+		_, _ = w.Write(rb)
+	})
+
+	scopes := make([]GithubConnectionScope, 2*scopesBatchSize+1)
+	for i := range scopes {
+		scopes[i].GithubId = i
+	}
+	createdScopes, err := c.CreateGithubConnectionScopes(t.Context(), "1", scopes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(createdScopes) != len(scopes) || createdScopes[len(scopes)-1].GithubId != len(scopes)-1 {
+		t.Errorf("got %d scopes, want %d", len(createdScopes), len(scopes))
+	}
+	if want := fmt.Sprint([]int{scopesBatchSize, scopesBatchSize, 1}); fmt.Sprint(batches) != want {
+		t.Errorf("got batches %v, want %s", batches, want)
+	}
+}
+
+func TestListScopesPagination(t *testing.T) {
+	var pages []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+
+		count := scopesPageSize
+		if r.URL.Query().Get("page") == "2" {
+			count = 1
+		}
+		scopes := make([]string, count)
+		for i := range scopes {
+			scopes[i] = `{"scope":{"GithubId":1},"scopeConfig":{"id":1}}`
+		}
+		fmt.Fprintf(w, `{"count":%d,"scopes":[%s]}`, scopesPageSize+1, strings.Join(scopes, ","))
+	})
+
+	scopes, err := c.ListGithubConnectionScopes(t.Context(), "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(scopes) != scopesPageSize+1 || scopes[0].GithubId != 1 {
+		t.Errorf("got %d scopes, want %d", len(scopes), scopesPageSize+1)
+	}
+	if fmt.Sprint(pages) != "[1 2]" {
+		t.Errorf("got pages %v, want [1 2]", pages)
+	}
+}
+
+func TestListScopeDetails(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("searchTerm"); got != "devlake" {
+			t.Errorf("got searchTerm %q, want devlake", got)
+		}
+		if got := r.URL.Query().Get("blueprints"); got != "true" {
+			t.Errorf("got blueprints %q, want true", got)
+		}
+		fmt.Fprint(w, `{"count":2,"scopes":[
+			{"scope":{"GithubId":1},"scopeConfig":{"id":3,"name":"conf"},"blueprints":[{"id":7,"name":"bp"}]},
+			{"scope":{"GithubId":2},"scopeConfig":null,"blueprints":null}
+		]}`)
+	})
+
+	details, err := c.ListGithubConnectionScopeDetails(t.Context(), "1", "devlake")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(details) != 2 {
+		t.Fatalf("got %d scopes, want 2", len(details))
+	}
+	if details[0].ScopeConfig == nil || details[0].ScopeConfig.Name != "conf" || len(details[0].Blueprints) != 1 || details[0].Blueprints[0].ID != 7 {
+		t.Errorf("unexpected scope detail %+v", details[0])
+	}
+	if details[1].Scope.GithubId != 2 || details[1].ScopeConfig != nil {
+		t.Errorf("unexpected scope detail %+v", details[1])
+	}
+}
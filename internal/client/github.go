@@ -3,10 +3,8 @@
 package client
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
-	"strings"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -14,27 +12,37 @@ import (
 ////////////////////////////////////////////////////////////////////////////////
 
 // CreateGithubConnection - Creates new github connection.
-func (c *Client) CreateGithubConnection(connection GithubConnection) (*GithubConnection, error) {
+func (c *Client) CreateGithubConnection(ctx context.Context, connection GithubConnection) (*GithubConnection, error) {
 	url := fmt.Sprintf("%s/plugins/github/connections", c.HostURL)
-	return create(c, url, connection)
+	return create(ctx, c, url, connection)
+}
+
+// ListGithubConnections - Returns all github connections.
+func (c *Client) ListGithubConnections(ctx context.Context) ([]GithubConnection, error) {
+	url := fmt.Sprintf("%s/plugins/github/connections", c.HostURL)
+	connections, err := read[[]GithubConnection](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+	return *connections, nil
 }
 
 // ReadGithubConnection - Returns github connection.
-func (c *Client) ReadGithubConnection(id string) (*GithubConnection, error) {
+func (c *Client) ReadGithubConnection(ctx context.Context, id string) (*GithubConnection, error) {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s", c.HostURL, id)
-	return read[GithubConnection](c, url)
+	return read[GithubConnection](ctx, c, url)
 }
 
 // UpdateGithubConnection - Updates github connection.
-func (c *Client) UpdateGithubConnection(id string, connection GithubConnection) (*GithubConnection, error) {
+func (c *Client) UpdateGithubConnection(ctx context.Context, id string, connection GithubConnection) (*GithubConnection, error) {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s", c.HostURL, id)
-	return update(c, url, connection)
+	return update(ctx, c, url, connection)
 }
 
 // DeleteGithubConnection - Deletes a github connection.
-func (c *Client) DeleteGithubConnection(id string) error {
+func (c *Client) DeleteGithubConnection(ctx context.Context, id string) error {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s", c.HostURL, id)
-	return del(c, url)
+	return del(ctx, c, url)
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -42,27 +50,27 @@ func (c *Client) DeleteGithubConnection(id string) error {
 ////////////////////////////////////////////////////////////////////////////////
 
 // CreateGithubConnectionScopeConfig - Creates a github connection scope config.
-func (c *Client) CreateGithubConnectionScopeConfig(connectionId string, scopeConfig GithubConnectionScopeConfig) (*GithubConnectionScopeConfig, error) {
+func (c *Client) CreateGithubConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig GithubConnectionScopeConfig) (*GithubConnectionScopeConfig, error) {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s/scope-configs", c.HostURL, connectionId)
-	return create(c, url, scopeConfig)
+	return create(ctx, c, url, scopeConfig)
 }
 
 // ReadGithubConnectionScopeConfig - Reads a github connection scope config.
-func (c *Client) ReadGithubConnectionScopeConfig(connectionId, scopeConfigId string) (*GithubConnectionScopeConfig, error) {
+func (c *Client) ReadGithubConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*GithubConnectionScopeConfig, error) {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
-	return read[GithubConnectionScopeConfig](c, url)
+	return read[GithubConnectionScopeConfig](ctx, c, url)
 }
 
 // UpdateGithubConnectionScopeConfig - Updates a github connection scope config.
-func (c *Client) UpdateGithubConnectionScopeConfig(connectionId, scopeConfigId string, scopeConfig GithubConnectionScopeConfig) (*GithubConnectionScopeConfig, error) {
+func (c *Client) UpdateGithubConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig GithubConnectionScopeConfig) (*GithubConnectionScopeConfig, error) {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
-	return update(c, url, scopeConfig)
+	return update(ctx, c, url, scopeConfig)
 }
 
 // DeleteGithubConnectionScopeConfig - Deletes a github connection scope config.
-func (c *Client) DeleteGithubConnectionScopeConfig(connectionId, scopeConfigId string) error {
+func (c *Client) DeleteGithubConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
-	return del(c, url)
+	return del(ctx, c, url)
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -70,73 +78,62 @@ func (c *Client) DeleteGithubConnectionScopeConfig(connectionId, scopeConfigId s
 ////////////////////////////////////////////////////////////////////////////////
 
 // CreateGithubConnectionScope - Creates a github connection scope.
-func (c *Client) CreateGithubConnectionScope(connectionId string, scope GithubConnectionScope) (*GithubConnectionScope, error) {
-	data := struct {
-		Data []GithubConnectionScope `json:"data"`
-	}{
-		Data: []GithubConnectionScope{scope},
-	}
-	rb, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/plugins/github/connections/%s/scopes", c.HostURL, connectionId), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Add("content-type", "application/json")
+func (c *Client) CreateGithubConnectionScope(ctx context.Context, connectionId string, scope GithubConnectionScope) (*GithubConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/github/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// CreateGithubConnectionScopes - Creates or updates many github connection scopes.
+func (c *Client) CreateGithubConnectionScopes(ctx context.Context, connectionId string, scopes []GithubConnectionScope) ([]GithubConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/github/connections/%s/scopes", c.HostURL, connectionId)
+	return createScopes(ctx, c, url, scopes)
+}
 
-	// endpoint accepts list but we only ever create one scope at a time, can
-	// not use generic function because of this
-	createdScopes := []GithubConnectionScope{}
-	err = json.Unmarshal(body, &createdScopes)
-	if err != nil {
-		return nil, err
-	}
+// ListGithubConnectionScopes - Lists all github connection scopes.
+func (c *Client) ListGithubConnectionScopes(ctx context.Context, connectionId string) ([]GithubConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/github/connections/%s/scopes", c.HostURL, connectionId)
+	return listScopes[GithubConnectionScope](ctx, c, url)
+}
 
-	return &createdScopes[0], nil
+// ListGithubConnectionScopeDetails - Lists the github connection scopes with
+// their scope configs and the blueprints referencing them, optionally
+// filtered by a search term.
+func (c *Client) ListGithubConnectionScopeDetails(ctx context.Context, connectionId, searchTerm string) ([]ScopeDetail[GithubConnectionScope, GithubConnectionScopeConfig], error) {
+	url := fmt.Sprintf("%s/plugins/github/connections/%s/scopes", c.HostURL, connectionId)
+	return listScopeDetails[GithubConnectionScope, GithubConnectionScopeConfig](ctx, c, url, searchTerm, true)
 }
 
 // ReadGithubConnectionScope - Reads a github connection scope.
-func (c *Client) ReadGithubConnectionScope(connectionId, scopeId string) (*GithubConnectionScope, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/plugins/github/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Add("content-type", "application/json")
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	// can not use generic read since response also contains the scope config
-	res := struct {
-		Scope       GithubConnectionScope       `json:"scope"`
-		ScopeConfig GithubConnectionScopeConfig `json:"scopeConfig"`
-	}{}
-	err = json.Unmarshal(body, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Scope, nil
+func (c *Client) ReadGithubConnectionScope(ctx context.Context, connectionId, scopeId string) (*GithubConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/github/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[GithubConnectionScope](ctx, c, url)
 }
 
 // UpdateGithubConnectionScope - Updates a github connection scope.
-func (c *Client) UpdateGithubConnectionScope(connectionId, scopeId string, scopeConfig GithubConnectionScope) (*GithubConnectionScope, error) {
+func (c *Client) UpdateGithubConnectionScope(ctx context.Context, connectionId, scopeId string, scopeConfig GithubConnectionScope) (*GithubConnectionScope, error) {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
-	return update(c, url, scopeConfig)
+	return update(ctx, c, url, scopeConfig)
 }
 
 // DeleteGithubConnectionScope - Deletes a github connection scope.
-func (c *Client) DeleteGithubConnectionScope(connectionId, scopeId string) error {
+func (c *Client) DeleteGithubConnectionScope(ctx context.Context, connectionId, scopeId string) error {
 	url := fmt.Sprintf("%s/plugins/github/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
-	return del(c, url)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// REMOTE SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// ListGithubRemoteScopes - Lists the repositories of a github organization or
+// user.
+func (c *Client) ListGithubRemoteScopes(ctx context.Context, connectionId, owner string) ([]GithubConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/github/connections/%s/remote-scopes", c.HostURL, connectionId)
+	return listRemoteScopes[GithubConnectionScope](ctx, c, url, owner)
+}
+
+// SearchGithubRemoteScopes - Searches github repositories by name.
+func (c *Client) SearchGithubRemoteScopes(ctx context.Context, connectionId, search string) ([]GithubConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/github/connections/%s/search-remote-scopes", c.HostURL, connectionId)
+	return searchRemoteScopes[GithubConnectionScope](ctx, c, url, search)
 }
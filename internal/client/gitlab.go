@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateGitlabConnection - Creates new gitlab connection.
+func (c *Client) CreateGitlabConnection(ctx context.Context, connection GitlabConnection) (*GitlabConnection, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadGitlabConnection - Returns gitlab connection.
+func (c *Client) ReadGitlabConnection(ctx context.Context, id string) (*GitlabConnection, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s", c.HostURL, id)
+	return read[GitlabConnection](ctx, c, url)
+}
+
+// UpdateGitlabConnection - Updates gitlab connection.
+func (c *Client) UpdateGitlabConnection(ctx context.Context, id string, connection GitlabConnection) (*GitlabConnection, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteGitlabConnection - Deletes a gitlab connection.
+func (c *Client) DeleteGitlabConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE CONFIG
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateGitlabConnectionScopeConfig - Creates a gitlab connection scope config.
+func (c *Client) CreateGitlabConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig GitlabConnectionScopeConfig) (*GitlabConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scope-configs", c.HostURL, connectionId)
+	return create(ctx, c, url, scopeConfig)
+}
+
+// ReadGitlabConnectionScopeConfig - Reads a gitlab connection scope config.
+func (c *Client) ReadGitlabConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*GitlabConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return read[GitlabConnectionScopeConfig](ctx, c, url)
+}
+
+// UpdateGitlabConnectionScopeConfig - Updates a gitlab connection scope config.
+func (c *Client) UpdateGitlabConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig GitlabConnectionScopeConfig) (*GitlabConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteGitlabConnectionScopeConfig - Deletes a gitlab connection scope config.
+func (c *Client) DeleteGitlabConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateGitlabConnectionScope - Creates a gitlab connection scope.
+func (c *Client) CreateGitlabConnectionScope(ctx context.Context, connectionId string, scope GitlabConnectionScope) (*GitlabConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadGitlabConnectionScope - Reads a gitlab connection scope.
+func (c *Client) ReadGitlabConnectionScope(ctx context.Context, connectionId, scopeId string) (*GitlabConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[GitlabConnectionScope](ctx, c, url)
+}
+
+// UpdateGitlabConnectionScope - Updates a gitlab connection scope.
+func (c *Client) UpdateGitlabConnectionScope(ctx context.Context, connectionId, scopeId string, scopeConfig GitlabConnectionScope) (*GitlabConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteGitlabConnectionScope - Deletes a gitlab connection scope.
+func (c *Client) DeleteGitlabConnectionScope(ctx context.Context, connectionId, scopeId string) error {
+	url := fmt.Sprintf("%s/plugins/gitlab/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return del(ctx, c, url)
+}
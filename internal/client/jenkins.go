@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateJenkinsConnection - Creates new jenkins connection.
+func (c *Client) CreateJenkinsConnection(ctx context.Context, connection JenkinsConnection) (*JenkinsConnection, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadJenkinsConnection - Returns jenkins connection.
+func (c *Client) ReadJenkinsConnection(ctx context.Context, id string) (*JenkinsConnection, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s", c.HostURL, id)
+	return read[JenkinsConnection](ctx, c, url)
+}
+
+// UpdateJenkinsConnection - Updates jenkins connection.
+func (c *Client) UpdateJenkinsConnection(ctx context.Context, id string, connection JenkinsConnection) (*JenkinsConnection, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteJenkinsConnection - Deletes a jenkins connection.
+func (c *Client) DeleteJenkinsConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE CONFIG
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateJenkinsConnectionScopeConfig - Creates a jenkins connection scope config.
+func (c *Client) CreateJenkinsConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig JenkinsConnectionScopeConfig) (*JenkinsConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scope-configs", c.HostURL, connectionId)
+	return create(ctx, c, url, scopeConfig)
+}
+
+// ReadJenkinsConnectionScopeConfig - Reads a jenkins connection scope config.
+func (c *Client) ReadJenkinsConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*JenkinsConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return read[JenkinsConnectionScopeConfig](ctx, c, url)
+}
+
+// UpdateJenkinsConnectionScopeConfig - Updates a jenkins connection scope config.
+func (c *Client) UpdateJenkinsConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig JenkinsConnectionScopeConfig) (*JenkinsConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteJenkinsConnectionScopeConfig - Deletes a jenkins connection scope config.
+func (c *Client) DeleteJenkinsConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateJenkinsConnectionScope - Creates a jenkins connection scope.
+func (c *Client) CreateJenkinsConnectionScope(ctx context.Context, connectionId string, scope JenkinsConnectionScope) (*JenkinsConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadJenkinsConnectionScope - Reads a jenkins connection scope.
+func (c *Client) ReadJenkinsConnectionScope(ctx context.Context, connectionId, fullName string) (*JenkinsConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scopes/%s", c.HostURL, connectionId, escapeJenkinsFullName(fullName))
+	return readScope[JenkinsConnectionScope](ctx, c, url)
+}
+
+// UpdateJenkinsConnectionScope - Updates a jenkins connection scope.
+func (c *Client) UpdateJenkinsConnectionScope(ctx context.Context, connectionId, fullName string, scope JenkinsConnectionScope) (*JenkinsConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scopes/%s", c.HostURL, connectionId, escapeJenkinsFullName(fullName))
+	return update(ctx, c, url, scope)
+}
+
+// DeleteJenkinsConnectionScope - Deletes a jenkins connection scope.
+func (c *Client) DeleteJenkinsConnectionScope(ctx context.Context, connectionId, fullName string) error {
+	url := fmt.Sprintf("%s/plugins/jenkins/connections/%s/scopes/%s", c.HostURL, connectionId, escapeJenkinsFullName(fullName))
+	return del(ctx, c, url)
+}
+
+// escapeJenkinsFullName - Escapes the folders and the name of a job full name
+// like 'folder/job' for the scope url, devlake matches the slashes between
+// them with a wildcard.
+func escapeJenkinsFullName(fullName string) string {
+	segments := strings.Split(fullName, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
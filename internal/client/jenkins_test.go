@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestJenkinsConnectionScopeFullNameEscaping(t *testing.T) {
+	var path string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+	})
+
+	if err := c.DeleteJenkinsConnectionScope(t.Context(), "1", "team folder/deploy#prod"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := "/plugins/jenkins/connections/1/scopes/team%20folder/deploy%23prod"; path != want {
+		t.Errorf("got path %q, want %q", path, want)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateJiraConnection - Creates new jira connection.
+func (c *Client) CreateJiraConnection(ctx context.Context, connection JiraConnection) (*JiraConnection, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadJiraConnection - Returns jira connection.
+func (c *Client) ReadJiraConnection(ctx context.Context, id string) (*JiraConnection, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s", c.HostURL, id)
+	return read[JiraConnection](ctx, c, url)
+}
+
+// UpdateJiraConnection - Updates jira connection.
+func (c *Client) UpdateJiraConnection(ctx context.Context, id string, connection JiraConnection) (*JiraConnection, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteJiraConnection - Deletes a jira connection.
+func (c *Client) DeleteJiraConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE CONFIG
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateJiraConnectionScopeConfig - Creates a jira connection scope config.
+func (c *Client) CreateJiraConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig JiraConnectionScopeConfig) (*JiraConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scope-configs", c.HostURL, connectionId)
+	return create(ctx, c, url, scopeConfig)
+}
+
+// ReadJiraConnectionScopeConfig - Reads a jira connection scope config.
+func (c *Client) ReadJiraConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*JiraConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return read[JiraConnectionScopeConfig](ctx, c, url)
+}
+
+// UpdateJiraConnectionScopeConfig - Updates a jira connection scope config.
+func (c *Client) UpdateJiraConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig JiraConnectionScopeConfig) (*JiraConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteJiraConnectionScopeConfig - Deletes a jira connection scope config.
+func (c *Client) DeleteJiraConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateJiraConnectionScope - Creates a jira connection scope.
+func (c *Client) CreateJiraConnectionScope(ctx context.Context, connectionId string, scope JiraConnectionScope) (*JiraConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadJiraConnectionScope - Reads a jira connection scope.
+func (c *Client) ReadJiraConnectionScope(ctx context.Context, connectionId, scopeId string) (*JiraConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[JiraConnectionScope](ctx, c, url)
+}
+
+// UpdateJiraConnectionScope - Updates a jira connection scope.
+func (c *Client) UpdateJiraConnectionScope(ctx context.Context, connectionId, scopeId string, scopeConfig JiraConnectionScope) (*JiraConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteJiraConnectionScope - Deletes a jira connection scope.
+func (c *Client) DeleteJiraConnectionScope(ctx context.Context, connectionId, scopeId string) error {
+	url := fmt.Sprintf("%s/plugins/jira/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return del(ctx, c, url)
+}
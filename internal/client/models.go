@@ -2,6 +2,8 @@
 
 package client
 
+import "encoding/json"
+
 type ApiKey struct {
 	ID           int    `json:"id"`
 	AllowedPath  string `json:"allowedPath"`
@@ -33,6 +35,86 @@ type JsonBody struct {
 	Success bool     `json:"success"`
 }
 
+type AzureDevopsConnection struct {
+	ID               int    `json:"id"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Organization     string `json:"organization"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	Token            string `json:"token"`
+	UpdatedAt        string `json:"updatedAt"`
+}
+
+type AzureDevopsConnectionScopeConfig struct {
+	ConnectionId      int      `json:"connectionId"`
+	CreatedAt         string   `json:"createdAt"`
+	DeploymentPattern string   `json:"deploymentPattern"`
+	Entities          []string `json:"entities"`
+	ID                int      `json:"id"`
+	Name              string   `json:"name"`
+	ProductionPattern string   `json:"productionPattern"`
+	RefDiff           *RefDiff `json:"refdiff"`
+	UpdatedAt         string   `json:"updatedAt"`
+}
+
+type AzureDevopsConnectionScope struct {
+	ConnectionId   int    `json:"connectionId"`
+	CreatedAt      string `json:"createdAt"`
+	ID             string `json:"id"`
+	IsFork         bool   `json:"isFork"`
+	IsPrivate      bool   `json:"isPrivate"`
+	Name           string `json:"name"`
+	OrganizationId string `json:"organizationId"`
+	ProjectId      string `json:"projectId"`
+	RemoteUrl      string `json:"remoteUrl"`
+	ScopeConfigId  int    `json:"scopeConfigId"`
+	Type           string `json:"type"`
+	UpdatedAt      string `json:"updatedAt"`
+	Url            string `json:"url"`
+}
+
+type BitbucketConnection struct {
+	ID               int    `json:"id"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Password         string `json:"password"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	UpdatedAt        string `json:"updatedAt"`
+	Username         string `json:"username"`
+}
+
+type BitbucketConnectionScopeConfig struct {
+	ConnectionId          int      `json:"connectionId"`
+	CreatedAt             string   `json:"createdAt"`
+	DeploymentPattern     string   `json:"deploymentPattern"`
+	Entities              []string `json:"entities"`
+	ID                    int      `json:"id"`
+	IssueStatusDone       string   `json:"issueStatusDone"`
+	IssueStatusInProgress string   `json:"issueStatusInProgress"`
+	IssueStatusOther      string   `json:"issueStatusOther"`
+	IssueStatusTodo       string   `json:"issueStatusTodo"`
+	Name                  string   `json:"name"`
+	ProductionPattern     string   `json:"productionPattern"`
+	RefDiff               *RefDiff `json:"refdiff"`
+	UpdatedAt             string   `json:"updatedAt"`
+}
+
+type BitbucketConnectionScope struct {
+	BitbucketId   string `json:"bitbucketId"`
+	CloneUrl      string `json:"cloneUrl"`
+	ConnectionId  int    `json:"connectionId"`
+	CreatedAt     string `json:"createdAt"`
+	Description   string `json:"description"`
+	HTMLUrl       string `json:"HTMLUrl"`
+	Name          string `json:"name"`
+	ScopeConfigId int    `json:"scopeConfigId"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
 type BitbucketServerConnection struct {
 	ID               int    `json:"id"`
 	CreatedAt        string `json:"createdAt"`
@@ -130,3 +212,341 @@ type GithubConnectionScope struct {
 	UpdatedAt     string `json:"UpdatedAt"`
 	UpdatedDate   string `json:"UpdatedDate"`
 }
+
+// ScopeDetail is an entry of the scopes of a plugin connection with the
+// attached scope config and the blueprints referencing the scope.
+type ScopeDetail[T, C any] struct {
+	Blueprints  []Blueprint `json:"blueprints"`
+	Scope       T           `json:"scope"`
+	ScopeConfig *C          `json:"scopeConfig"`
+}
+
+// RemoteScope is an entry of the remote scopes of a plugin connection, either
+// a group like a github organization or a scope with the plugin specific data.
+type RemoteScope[T any] struct {
+	Data     *T     `json:"data"`
+	FullName string `json:"fullName"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	ParentId string `json:"parentId"`
+	Type     string `json:"type"`
+}
+
+type Project struct {
+	Blueprint   *Blueprint      `json:"blueprint,omitempty"`
+	CreatedAt   string          `json:"createdAt"`
+	Description string          `json:"description"`
+	Metrics     []ProjectMetric `json:"metrics"`
+	Name        string          `json:"name"`
+	UpdatedAt   string          `json:"updatedAt"`
+}
+
+type ProjectMetric struct {
+	Enable       bool            `json:"enable"`
+	PluginName   string          `json:"pluginName"`
+	PluginOption json.RawMessage `json:"pluginOption,omitempty"`
+}
+
+type Blueprint struct {
+	ID          int                   `json:"id"`
+	Connections []BlueprintConnection `json:"connections"`
+	CreatedAt   string                `json:"createdAt,omitempty"`
+	CronConfig  string                `json:"cronConfig"`
+	Enable      bool                  `json:"enable"`
+	IsManual    bool                  `json:"isManual"`
+	Mode        string                `json:"mode"`
+	Name        string                `json:"name"`
+	Plan        json.RawMessage       `json:"plan,omitempty"`
+	ProjectName string                `json:"projectName"`
+	SkipOnFail  bool                  `json:"skipOnFail"`
+	TimeAfter   *string               `json:"timeAfter"`
+	UpdatedAt   string                `json:"updatedAt,omitempty"`
+}
+
+type BlueprintTrigger struct {
+	FullSync       bool `json:"fullSync"`
+	SkipCollectors bool `json:"skipCollectors"`
+}
+
+type Pipeline struct {
+	ID             int     `json:"id"`
+	BeganAt        *string `json:"beganAt"`
+	BlueprintId    int     `json:"blueprintId"`
+	CreatedAt      string  `json:"createdAt"`
+	ErrorName      string  `json:"errorName"`
+	FinishedAt     *string `json:"finishedAt"`
+	FinishedTasks  int     `json:"finishedTasks"`
+	FullSync       bool    `json:"fullSync"`
+	Message        string  `json:"message"`
+	Name           string  `json:"name"`
+	SkipCollectors bool    `json:"skipCollectors"`
+	SpentSeconds   int     `json:"spentSeconds"`
+	Status         string  `json:"status"`
+	TotalTasks     int     `json:"totalTasks"`
+	UpdatedAt      string  `json:"updatedAt"`
+}
+
+type PipelineQuery struct {
+	BlueprintId string
+	Page        int
+	PageSize    int
+	Status      string
+}
+
+type PipelineTask struct {
+	ID            int    `json:"id"`
+	ErrorName     string `json:"errorName"`
+	FailedSubTask string `json:"failedSubTask"`
+	Message       string `json:"message"`
+	PipelineId    int    `json:"pipelineId"`
+	Plugin        string `json:"plugin"`
+	Status        string `json:"status"`
+}
+
+type BlueprintConnection struct {
+	ConnectionId int              `json:"connectionId"`
+	PluginName   string           `json:"pluginName"`
+	Scopes       []BlueprintScope `json:"scopes"`
+}
+
+type BlueprintScope struct {
+	ScopeId string `json:"scopeId"`
+}
+
+type GitlabConnection struct {
+	ID               int    `json:"id"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	Token            string `json:"token"`
+	UpdatedAt        string `json:"updatedAt"`
+}
+
+type GitlabConnectionScopeConfig struct {
+	ConnectionId         int      `json:"connectionId"`
+	CreatedAt            string   `json:"createdAt"`
+	DeploymentPattern    string   `json:"deploymentPattern"`
+	Entities             []string `json:"entities"`
+	EnvNamePattern       string   `json:"envNamePattern"`
+	ID                   int      `json:"id"`
+	IssueComponent       string   `json:"issueComponent"`
+	IssuePriority        string   `json:"issuePriority"`
+	IssueSeverity        string   `json:"issueSeverity"`
+	IssueTypeBug         string   `json:"issueTypeBug"`
+	IssueTypeIncident    string   `json:"issueTypeIncident"`
+	IssueTypeRequirement string   `json:"issueTypeRequirement"`
+	Name                 string   `json:"name"`
+	PrBodyClosePattern   string   `json:"prBodyClosePattern"`
+	PrComponent          string   `json:"prComponent"`
+	PrType               string   `json:"prType"`
+	ProductionPattern    string   `json:"productionPattern"`
+	RefDiff              *RefDiff `json:"refdiff"`
+	UpdatedAt            string   `json:"updatedAt"`
+}
+
+type GitlabConnectionScope struct {
+	ConnectionId      int    `json:"connectionId"`
+	CreatedAt         string `json:"createdAt"`
+	Description       string `json:"description"`
+	GitlabId          int    `json:"gitlabId"`
+	HttpUrlToRepo     string `json:"httpUrlToRepo"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"pathWithNamespace"`
+	ScopeConfigId     int    `json:"scopeConfigId"`
+	UpdatedAt         string `json:"updatedAt"`
+	WebUrl            string `json:"webUrl"`
+}
+
+type JenkinsConnection struct {
+	ID               int    `json:"id"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Password         string `json:"password"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	UpdatedAt        string `json:"updatedAt"`
+	Username         string `json:"username"`
+}
+
+type JenkinsConnectionScopeConfig struct {
+	ConnectionId      int      `json:"connectionId"`
+	CreatedAt         string   `json:"createdAt"`
+	DeploymentPattern string   `json:"deploymentPattern"`
+	Entities          []string `json:"entities"`
+	ID                int      `json:"id"`
+	Name              string   `json:"name"`
+	ProductionPattern string   `json:"productionPattern"`
+	UpdatedAt         string   `json:"updatedAt"`
+}
+
+type JenkinsConnectionScope struct {
+	ConnectionId  int    `json:"connectionId"`
+	CreatedAt     string `json:"createdAt"`
+	Description   string `json:"description"`
+	FullName      string `json:"fullName"`
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	ScopeConfigId int    `json:"scopeConfigId"`
+	UpdatedAt     string `json:"updatedAt"`
+	Url           string `json:"url"`
+}
+
+type JiraConnection struct {
+	ID               int    `json:"id"`
+	AuthMethod       string `json:"authMethod"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Password         string `json:"password,omitempty"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	Token            string `json:"token,omitempty"`
+	UpdatedAt        string `json:"updatedAt"`
+	Username         string `json:"username,omitempty"`
+}
+
+type JiraConnectionScopeConfig struct {
+	ConnectionId               int                        `json:"connectionId"`
+	CreatedAt                  string                     `json:"createdAt"`
+	ID                         int                        `json:"id"`
+	Entities                   []string                   `json:"entities"`
+	Name                       string                     `json:"name"`
+	RemotelinkCommitShaPattern string                     `json:"remotelinkCommitShaPattern"`
+	StoryPointField            string                     `json:"storyPointField"`
+	TypeMappings               map[string]JiraTypeMapping `json:"typeMappings"`
+	UpdatedAt                  string                     `json:"updatedAt"`
+}
+
+type JiraTypeMapping struct {
+	StandardType   string                       `json:"standardType"`
+	StatusMappings map[string]JiraStatusMapping `json:"statusMappings"`
+}
+
+type JiraStatusMapping struct {
+	StandardStatus string `json:"standardStatus"`
+}
+
+type JiraConnectionScope struct {
+	BoardId       int    `json:"boardId"`
+	ConnectionId  int    `json:"connectionId"`
+	CreatedAt     string `json:"createdAt"`
+	Name          string `json:"name"`
+	ProjectId     int    `json:"projectId"`
+	ScopeConfigId int    `json:"scopeConfigId"`
+	Self          string `json:"self"`
+	Type          string `json:"type"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
+type OpsgenieConnection struct {
+	ID               int    `json:"id"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	Token            string `json:"token"`
+	UpdatedAt        string `json:"updatedAt"`
+}
+
+type OpsgenieConnectionScopeConfig struct {
+	ConnectionId int      `json:"connectionId"`
+	CreatedAt    string   `json:"createdAt"`
+	Entities     []string `json:"entities"`
+	ID           int      `json:"id"`
+	Name         string   `json:"name"`
+	UpdatedAt    string   `json:"updatedAt"`
+}
+
+type OpsgenieConnectionScope struct {
+	ConnectionId  int    `json:"connectionId"`
+	CreatedAt     string `json:"createdAt"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	ScopeConfigId int    `json:"scopeConfigId"`
+	TeamId        string `json:"teamId"`
+	UpdatedAt     string `json:"updatedAt"`
+	Url           string `json:"url"`
+}
+
+type OrgTeam struct {
+	Alias     string
+	ID        string
+	Name      string
+	ParentId  string
+	SortIndex int
+}
+
+type OrgUser struct {
+	Email   string
+	ID      string
+	Name    string
+	TeamIds []string
+}
+
+type OrgUserAccountMapping struct {
+	AccountId string
+	UserId    string
+}
+
+type PagerDutyConnection struct {
+	ID               int    `json:"id"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	Token            string `json:"token"`
+	UpdatedAt        string `json:"updatedAt"`
+}
+
+type PagerDutyConnectionScopeConfig struct {
+	ConnectionId int      `json:"connectionId"`
+	CreatedAt    string   `json:"createdAt"`
+	Entities     []string `json:"entities"`
+	ID           int      `json:"id"`
+	Name         string   `json:"name"`
+	UpdatedAt    string   `json:"updatedAt"`
+}
+
+type PagerDutyConnectionScope struct {
+	ConnectionId  int    `json:"connectionId"`
+	CreatedAt     string `json:"createdAt"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	ScopeConfigId int    `json:"scopeConfigId"`
+	UpdatedAt     string `json:"updatedAt"`
+	Url           string `json:"url"`
+}
+
+type SonarqubeConnection struct {
+	ID               int    `json:"id"`
+	CreatedAt        string `json:"createdAt"`
+	Endpoint         string `json:"endpoint"`
+	Name             string `json:"name"`
+	Proxy            string `json:"proxy"`
+	RateLimitPerHour int    `json:"rateLimitPerHour"`
+	Token            string `json:"token"`
+	UpdatedAt        string `json:"updatedAt"`
+}
+
+type SonarqubeConnectionScope struct {
+	ConnectionId int    `json:"connectionId"`
+	CreatedAt    string `json:"createdAt"`
+	Name         string `json:"name"`
+	ProjectKey   string `json:"projectKey"`
+	Qualifier    string `json:"qualifier"`
+	UpdatedAt    string `json:"updatedAt"`
+	Visibility   string `json:"visibility"`
+}
+
+type WebhookConnection struct {
+	ID        int     `json:"id"`
+	ApiKey    *ApiKey `json:"apiKey,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+	Name      string  `json:"name"`
+	UpdatedAt string  `json:"updatedAt"`
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateOpsgenieConnection - Creates new opsgenie connection.
+func (c *Client) CreateOpsgenieConnection(ctx context.Context, connection OpsgenieConnection) (*OpsgenieConnection, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadOpsgenieConnection - Returns opsgenie connection.
+func (c *Client) ReadOpsgenieConnection(ctx context.Context, id string) (*OpsgenieConnection, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s", c.HostURL, id)
+	return read[OpsgenieConnection](ctx, c, url)
+}
+
+// UpdateOpsgenieConnection - Updates opsgenie connection.
+func (c *Client) UpdateOpsgenieConnection(ctx context.Context, id string, connection OpsgenieConnection) (*OpsgenieConnection, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteOpsgenieConnection - Deletes an opsgenie connection.
+func (c *Client) DeleteOpsgenieConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE CONFIG
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateOpsgenieConnectionScopeConfig - Creates an opsgenie connection scope config.
+func (c *Client) CreateOpsgenieConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig OpsgenieConnectionScopeConfig) (*OpsgenieConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scope-configs", c.HostURL, connectionId)
+	return create(ctx, c, url, scopeConfig)
+}
+
+// ReadOpsgenieConnectionScopeConfig - Reads an opsgenie connection scope config.
+func (c *Client) ReadOpsgenieConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*OpsgenieConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return read[OpsgenieConnectionScopeConfig](ctx, c, url)
+}
+
+// UpdateOpsgenieConnectionScopeConfig - Updates an opsgenie connection scope config.
+func (c *Client) UpdateOpsgenieConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig OpsgenieConnectionScopeConfig) (*OpsgenieConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeleteOpsgenieConnectionScopeConfig - Deletes an opsgenie connection scope config.
+func (c *Client) DeleteOpsgenieConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateOpsgenieConnectionScope - Creates an opsgenie connection scope.
+func (c *Client) CreateOpsgenieConnectionScope(ctx context.Context, connectionId string, scope OpsgenieConnectionScope) (*OpsgenieConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadOpsgenieConnectionScope - Reads an opsgenie connection scope.
+func (c *Client) ReadOpsgenieConnectionScope(ctx context.Context, connectionId, scopeId string) (*OpsgenieConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[OpsgenieConnectionScope](ctx, c, url)
+}
+
+// UpdateOpsgenieConnectionScope - Updates an opsgenie connection scope.
+func (c *Client) UpdateOpsgenieConnectionScope(ctx context.Context, connectionId, scopeId string, scope OpsgenieConnectionScope) (*OpsgenieConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return update(ctx, c, url, scope)
+}
+
+// DeleteOpsgenieConnectionScope - Deletes an opsgenie connection scope.
+func (c *Client) DeleteOpsgenieConnectionScope(ctx context.Context, connectionId, scopeId string) error {
+	url := fmt.Sprintf("%s/plugins/opsgenie/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return del(ctx, c, url)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The org plugin only offers csv files for its data, every upload replaces
+// all existing records of the file.
+var (
+	orgTeamsHeader               = []string{"Id", "Name", "Alias", "ParentId", "SortIndex"}
+	orgUsersHeader               = []string{"Id", "Name", "Email", "TeamIds"}
+	orgUserAccountMappingsHeader = []string{"UserId", "AccountId"}
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// TEAMS
+////////////////////////////////////////////////////////////////////////////////
+
+// ReadOrgTeams - Returns all teams of the org plugin.
+func (c *Client) ReadOrgTeams(ctx context.Context) ([]OrgTeam, error) {
+	url := fmt.Sprintf("%s/plugins/org/teams.csv?fake_data=false", c.HostURL)
+	rows, err := readCsv(ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := []OrgTeam{}
+	for _, row := range rows {
+		team := OrgTeam{
+			Alias:    row["Alias"],
+			ID:       row["Id"],
+			Name:     row["Name"],
+			ParentId: row["ParentId"],
+		}
+		if row["SortIndex"] != "" {
+			team.SortIndex, err = strconv.Atoi(row["SortIndex"])
+			if err != nil {
+				return nil, fmt.Errorf("invalid sort index of team %s: %w", team.ID, err)
+			}
+		}
+		teams = append(teams, team)
+	}
+	return teams, nil
+}
+
+// UpdateOrgTeams - Replaces all teams of the org plugin.
+func (c *Client) UpdateOrgTeams(ctx context.Context, teams []OrgTeam) error {
+	url := fmt.Sprintf("%s/plugins/org/teams.csv", c.HostURL)
+	records := [][]string{}
+	for _, team := range teams {
+		records = append(records, []string{team.ID, team.Name, team.Alias, team.ParentId, strconv.Itoa(team.SortIndex)})
+	}
+	return uploadCsv(ctx, c, url, orgTeamsHeader, records)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// USERS
+////////////////////////////////////////////////////////////////////////////////
+
+// ReadOrgUsers - Returns all users of the org plugin.
+func (c *Client) ReadOrgUsers(ctx context.Context) ([]OrgUser, error) {
+	url := fmt.Sprintf("%s/plugins/org/users.csv?fake_data=false", c.HostURL)
+	rows, err := readCsv(ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []OrgUser{}
+	for _, row := range rows {
+		user := OrgUser{
+			Email:   row["Email"],
+			ID:      row["Id"],
+			Name:    row["Name"],
+			TeamIds: []string{},
+		}
+		// the team ids of a user are separated by semicolons
+		for _, teamId := range strings.Split(row["TeamIds"], ";") {
+			if teamId = strings.TrimSpace(teamId); teamId != "" {
+				user.TeamIds = append(user.TeamIds, teamId)
+			}
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// UpdateOrgUsers - Replaces all users of the org plugin.
+func (c *Client) UpdateOrgUsers(ctx context.Context, users []OrgUser) error {
+	url := fmt.Sprintf("%s/plugins/org/users.csv", c.HostURL)
+	records := [][]string{}
+	for _, user := range users {
+		records = append(records, []string{user.ID, user.Name, user.Email, strings.Join(user.TeamIds, ";")})
+	}
+	return uploadCsv(ctx, c, url, orgUsersHeader, records)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// USER ACCOUNT MAPPINGS
+////////////////////////////////////////////////////////////////////////////////
+
+// ReadOrgUserAccountMappings - Returns all mappings of users to the accounts
+// of the tools.
+func (c *Client) ReadOrgUserAccountMappings(ctx context.Context) ([]OrgUserAccountMapping, error) {
+	url := fmt.Sprintf("%s/plugins/org/user_account_mapping.csv", c.HostURL)
+	rows, err := readCsv(ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := []OrgUserAccountMapping{}
+	for _, row := range rows {
+		mappings = append(mappings, OrgUserAccountMapping{
+			AccountId: row["AccountId"],
+			UserId:    row["UserId"],
+		})
+	}
+	return mappings, nil
+}
+
+// UpdateOrgUserAccountMappings - Replaces all mappings of users to the
+// accounts of the tools.
+func (c *Client) UpdateOrgUserAccountMappings(ctx context.Context, mappings []OrgUserAccountMapping) error {
+	url := fmt.Sprintf("%s/plugins/org/user_account_mapping.csv", c.HostURL)
+	records := [][]string{}
+	for _, mapping := range mappings {
+		records = append(records, []string{mapping.UserId, mapping.AccountId})
+	}
+	return uploadCsv(ctx, c, url, orgUserAccountMappingsHeader, records)
+}
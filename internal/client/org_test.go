@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestReadOrgUsers(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/plugins/org/users.csv" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// the columns are looked up by the header, not by their position
+		fmt.Fprint(w, "Name,Id,Email,TeamIds\nJane,1,jane@example.com,\"1; 2\"\nJohn,2,,\n")
+	})
+
+	users, err := c.ReadOrgUsers(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("got %d users, want 2", len(users))
+	}
+	if users[0].ID != "1" || users[0].Name != "Jane" || users[0].Email != "jane@example.com" || !slices.Equal(users[0].TeamIds, []string{"1", "2"}) {
+		t.Errorf("unexpected user %+v", users[0])
+	}
+	if users[1].ID != "2" || users[1].Email != "" || len(users[1].TeamIds) != 0 {
+		t.Errorf("unexpected user %+v", users[1])
+	}
+}
+
+func TestUpdateOrgTeams(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/plugins/org/teams.csv" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("unexpected error reading the uploaded file: %s", err)
+			return
+		}
+		content, err := io.ReadAll(file)
+		if err != nil {
+			t.Errorf("unexpected error reading the uploaded file: %s", err)
+			return
+		}
+		if want := "Id,Name,Alias,ParentId,SortIndex\n1,Platform,,,0\n2,\"Core, Backend\",core,1,2\n"; string(content) != want {
+			t.Errorf("got csv %q, want %q", content, want)
+		}
+	})
+
+	err := c.UpdateOrgTeams(t.Context(), []OrgTeam{
+		{ID: "1", Name: "Platform"},
+		{ID: "2", Name: "Core, Backend", Alias: "core", ParentId: "1", SortIndex: 2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreatePagerDutyConnection - Creates new pagerduty connection.
+func (c *Client) CreatePagerDutyConnection(ctx context.Context, connection PagerDutyConnection) (*PagerDutyConnection, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadPagerDutyConnection - Returns pagerduty connection.
+func (c *Client) ReadPagerDutyConnection(ctx context.Context, id string) (*PagerDutyConnection, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s", c.HostURL, id)
+	return read[PagerDutyConnection](ctx, c, url)
+}
+
+// UpdatePagerDutyConnection - Updates pagerduty connection.
+func (c *Client) UpdatePagerDutyConnection(ctx context.Context, id string, connection PagerDutyConnection) (*PagerDutyConnection, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeletePagerDutyConnection - Deletes a pagerduty connection.
+func (c *Client) DeletePagerDutyConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE CONFIG
+////////////////////////////////////////////////////////////////////////////////
+
+// CreatePagerDutyConnectionScopeConfig - Creates a pagerduty connection scope config.
+func (c *Client) CreatePagerDutyConnectionScopeConfig(ctx context.Context, connectionId string, scopeConfig PagerDutyConnectionScopeConfig) (*PagerDutyConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scope-configs", c.HostURL, connectionId)
+	return create(ctx, c, url, scopeConfig)
+}
+
+// ReadPagerDutyConnectionScopeConfig - Reads a pagerduty connection scope config.
+func (c *Client) ReadPagerDutyConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) (*PagerDutyConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return read[PagerDutyConnectionScopeConfig](ctx, c, url)
+}
+
+// UpdatePagerDutyConnectionScopeConfig - Updates a pagerduty connection scope config.
+func (c *Client) UpdatePagerDutyConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string, scopeConfig PagerDutyConnectionScopeConfig) (*PagerDutyConnectionScopeConfig, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return update(ctx, c, url, scopeConfig)
+}
+
+// DeletePagerDutyConnectionScopeConfig - Deletes a pagerduty connection scope config.
+func (c *Client) DeletePagerDutyConnectionScopeConfig(ctx context.Context, connectionId, scopeConfigId string) error {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scope-configs/%s", c.HostURL, connectionId, scopeConfigId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreatePagerDutyConnectionScope - Creates a pagerduty connection scope.
+func (c *Client) CreatePagerDutyConnectionScope(ctx context.Context, connectionId string, scope PagerDutyConnectionScope) (*PagerDutyConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadPagerDutyConnectionScope - Reads a pagerduty connection scope.
+func (c *Client) ReadPagerDutyConnectionScope(ctx context.Context, connectionId, scopeId string) (*PagerDutyConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[PagerDutyConnectionScope](ctx, c, url)
+}
+
+// UpdatePagerDutyConnectionScope - Updates a pagerduty connection scope.
+func (c *Client) UpdatePagerDutyConnectionScope(ctx context.Context, connectionId, scopeId string, scope PagerDutyConnectionScope) (*PagerDutyConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return update(ctx, c, url, scope)
+}
+
+// DeletePagerDutyConnectionScope - Deletes a pagerduty connection scope.
+func (c *Client) DeletePagerDutyConnectionScope(ctx context.Context, connectionId, scopeId string) error {
+	url := fmt.Sprintf("%s/plugins/pagerduty/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return del(ctx, c, url)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ReadPipeline - Returns pipeline.
+func (c *Client) ReadPipeline(ctx context.Context, id string) (*Pipeline, error) {
+	url := fmt.Sprintf("%s/pipelines/%s", c.HostURL, id)
+	return read[Pipeline](ctx, c, url)
+}
+
+// ListPipelineTasks - Returns the tasks of a pipeline.
+func (c *Client) ListPipelineTasks(ctx context.Context, id string) ([]PipelineTask, error) {
+	url := fmt.Sprintf("%s/pipelines/%s/tasks", c.HostURL, id)
+	res, err := read[struct {
+		Count int            `json:"count"`
+		Tasks []PipelineTask `json:"tasks"`
+	}](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Tasks, nil
+}
+
+// ListPipelines - Returns a page of pipelines, the most recent first, and
+// the total count of pipelines matching the query.
+func (c *Client) ListPipelines(ctx context.Context, query PipelineQuery) ([]Pipeline, int, error) {
+	values := url.Values{}
+	if query.BlueprintId != "" {
+		values.Set("blueprint_id", query.BlueprintId)
+	}
+	if query.Status != "" {
+		values.Set("status", query.Status)
+	}
+	values.Set("page", strconv.Itoa(query.Page))
+	values.Set("pageSize", strconv.Itoa(query.PageSize))
+
+	res, err := read[struct {
+		Count     int        `json:"count"`
+		Pipelines []Pipeline `json:"pipelines"`
+	}](ctx, c, fmt.Sprintf("%s/pipelines?%s", c.HostURL, values.Encode()))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.Pipelines, res.Count, nil
+}
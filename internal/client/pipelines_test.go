@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTriggerBlueprint(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/blueprints/3/trigger" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var trigger BlueprintTrigger
+		if err := json.NewDecoder(r.Body).Decode(&trigger); err != nil {
+			t.Errorf("unexpected body: %s", err)
+		}
+		if !trigger.FullSync || trigger.SkipCollectors {
+			t.Errorf("unexpected trigger %+v", trigger)
+		}
+		fmt.Fprint(w, `{"id":7,"blueprintId":3,"status":"TASK_CREATED","beganAt":null,"totalTasks":2}`)
+	})
+
+	pipeline, err := c.TriggerBlueprint(t.Context(), "3", BlueprintTrigger{FullSync: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if pipeline.ID != 7 || pipeline.BlueprintId != 3 || pipeline.Status != "TASK_CREATED" || pipeline.BeganAt != nil {
+		t.Errorf("unexpected pipeline %+v", pipeline)
+	}
+}
+
+func TestListPipelineTasks(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pipelines/7/tasks" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"count":2,"tasks":[
+			{"id":1,"plugin":"github","status":"TASK_COMPLETED"},
+			{"id":2,"plugin":"gitextractor","status":"TASK_FAILED","failedSubTask":"Clone","message":"authentication failed"}
+		]}`)
+	})
+
+	tasks, err := c.ListPipelineTasks(t.Context(), "7")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(tasks) != 2 || tasks[1].FailedSubTask != "Clone" || tasks[1].Message != "authentication failed" {
+		t.Errorf("unexpected tasks %+v", tasks)
+	}
+}
+
+func TestListPipelines(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if want := "blueprint_id=3&page=2&pageSize=10&status=TASK_FAILED"; r.URL.RawQuery != want {
+			t.Errorf("got query %q, want %q", r.URL.RawQuery, want)
+		}
+		fmt.Fprint(w, `{"count":11,"pipelines":[{"id":1,"blueprintId":3,"status":"TASK_FAILED","message":"boom"}]}`)
+	})
+
+	pipelines, count, err := c.ListPipelines(t.Context(), PipelineQuery{BlueprintId: "3", Page: 2, PageSize: 10, Status: "TASK_FAILED"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if count != 11 || len(pipelines) != 1 || pipelines[0].Message != "boom" {
+		t.Errorf("unexpected pipelines %+v, count %d", pipelines, count)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CreateProject - Creates new project.
+func (c *Client) CreateProject(ctx context.Context, project Project) (*Project, error) {
+	url := fmt.Sprintf("%s/projects", c.HostURL)
+	return create(ctx, c, url, project)
+}
+
+// ReadProject - Returns project.
+func (c *Client) ReadProject(ctx context.Context, name string) (*Project, error) {
+	url := fmt.Sprintf("%s/projects/%s", c.HostURL, url.PathEscape(name))
+	return read[Project](ctx, c, url)
+}
+
+// UpdateProject - Updates project.
+func (c *Client) UpdateProject(ctx context.Context, name string, project Project) (*Project, error) {
+	url := fmt.Sprintf("%s/projects/%s", c.HostURL, url.PathEscape(name))
+	return update(ctx, c, url, project)
+}
+
+// DeleteProject - Deletes a project.
+func (c *Client) DeleteProject(ctx context.Context, name string) error {
+	url := fmt.Sprintf("%s/projects/%s", c.HostURL, url.PathEscape(name))
+	return del(ctx, c, url)
+}
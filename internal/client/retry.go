@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry settings of the client.
+const (
+	DefaultMaxRetries   int           = 3
+	DefaultRetryWaitMin time.Duration = 1 * time.Second
+	DefaultRetryWaitMax time.Duration = 30 * time.Second
+)
+
+// isIdempotent - Whether the request may be sent again without side effects.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry - Whether a failed request should be retried. Rate limited
+// requests were not processed and are always retried, transport errors and
+// unavailable backends only for idempotent requests.
+func shouldRetry(method string, res *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// backoff - Time to wait before the given retry attempt (starting at 1). A
+// Retry-After header takes precedence over the exponential backoff, both are
+// capped at the maximum wait time.
+func (c *Client) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, c.RetryWaitMax)
+		}
+	}
+
+	wait := float64(c.RetryWaitMin) * math.Pow(2, float64(attempt-1))
+	if wait > float64(c.RetryWaitMax) {
+		wait = float64(c.RetryWaitMax)
+	}
+
+	// full jitter on the upper half to spread the retries of parallel requests
+	half := time.Duration(wait / 2)
+	if half <= 0 {
+		return time.Duration(wait)
+	}
+	return half + rand.N(half)
+}
+
+// retryAfter - Parses a Retry-After header given in seconds or as http date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyClient returns a client against a backend answering the first
+// failures requests with the given status, and succeeding afterwards. Every
+// request body received is passed to bodies.
+func newFlakyClient(t *testing.T, failures int32, status int, header http.Header, bodies chan<- string) (*Client, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if bodies != nil {
+			body, _ := io.ReadAll(r.Body)
+			bodies <- string(body)
+		}
+		if requests.Add(1) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":1}`))
+	})
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = 5 * time.Millisecond
+	return c, &requests
+}
+
+func TestDoRequestRetriesIdempotentRequests(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		c, requests := newFlakyClient(t, 2, status, nil, nil)
+
+		if _, err := read[struct{}](t.Context(), c, c.HostURL+"/projects/p"); err != nil {
+			t.Fatalf("status %d: unexpected error: %s", status, err)
+		}
+		if got := requests.Load(); got != 3 {
+			t.Errorf("status %d: got %d requests, want 3", status, got)
+		}
+	}
+}
+
+func TestDoRequestDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	c, requests := newFlakyClient(t, 1, http.StatusServiceUnavailable, nil, nil)
+
+	_, err := create(t.Context(), c, c.HostURL+"/projects", struct{}{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestDoRequestRetriesRateLimitedRequests(t *testing.T) {
+	bodies := make(chan string, 3)
+	header := http.Header{"Retry-After": []string{"0"}}
+	c, requests := newFlakyClient(t, 2, http.StatusTooManyRequests, header, bodies)
+
+	if _, err := create(t.Context(), c, c.HostURL+"/projects", struct {
+		Name string `json:"name"`
+	}{Name: "p"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("got %d requests, want 3", got)
+	}
+	close(bodies)
+	for body := range bodies {
+		if body != `{"name":"p"}` {
+			t.Errorf("retried request sent body %q", body)
+		}
+	}
+}
+
+func TestDoRequestGivesUpAfterMaxRetries(t *testing.T) {
+	c, requests := newFlakyClient(t, 10, http.StatusServiceUnavailable, nil, nil)
+	c.MaxRetries = 2
+
+	_, err := read[struct{}](t.Context(), c, c.HostURL+"/projects/p")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if apiErr, ok := err.(*ApiError); !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected the last api error, got %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("got %d requests, want 3", got)
+	}
+}
+
+func TestDoRequestRetriesTransportErrors(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			// drop the connection without a response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	c.RetryWaitMin = time.Millisecond
+
+	if _, err := read[struct{}](t.Context(), c, c.HostURL+"/projects/p"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c := &Client{RetryWaitMin: time.Second, RetryWaitMax: 5 * time.Second}
+
+	tests := map[string]struct {
+		attempt int
+		header  string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		"first retry":          {attempt: 1, wantMin: 500 * time.Millisecond, wantMax: time.Second},
+		"third retry":          {attempt: 3, wantMin: 2 * time.Second, wantMax: 4 * time.Second},
+		"capped":               {attempt: 10, wantMin: 2500 * time.Millisecond, wantMax: 5 * time.Second},
+		"retry after":          {attempt: 1, header: "2", wantMin: 2 * time.Second, wantMax: 2 * time.Second},
+		"retry after capped":   {attempt: 1, header: "120", wantMin: 5 * time.Second, wantMax: 5 * time.Second},
+		"retry after past":     {attempt: 1, header: "Mon, 02 Jan 2006 15:04:05 GMT", wantMin: 0, wantMax: 0},
+		"retry after unparsed": {attempt: 1, header: "soon", wantMin: 500 * time.Millisecond, wantMax: time.Second},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				res.Header.Set("Retry-After", tt.header)
+			}
+			wait := c.backoff(tt.attempt, res)
+			if wait < tt.wantMin || wait > tt.wantMax {
+				t.Errorf("got %s, want between %s and %s", wait, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestDoRequestStopsRetryingWhenContextIsDone(t *testing.T) {
+	c, requests := newFlakyClient(t, 10, http.StatusServiceUnavailable, nil, nil)
+	c.RetryWaitMin = time.Minute
+	c.RetryWaitMax = time.Minute
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := read[struct{}](ctx, c, c.HostURL+"/projects/p")
+	if err != context.DeadlineExceeded {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateSonarqubeConnection - Creates new sonarqube connection.
+func (c *Client) CreateSonarqubeConnection(ctx context.Context, connection SonarqubeConnection) (*SonarqubeConnection, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadSonarqubeConnection - Returns sonarqube connection.
+func (c *Client) ReadSonarqubeConnection(ctx context.Context, id string) (*SonarqubeConnection, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s", c.HostURL, id)
+	return read[SonarqubeConnection](ctx, c, url)
+}
+
+// UpdateSonarqubeConnection - Updates sonarqube connection.
+func (c *Client) UpdateSonarqubeConnection(ctx context.Context, id string, connection SonarqubeConnection) (*SonarqubeConnection, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteSonarqubeConnection - Deletes a sonarqube connection.
+func (c *Client) DeleteSonarqubeConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateSonarqubeConnectionScope - Creates a sonarqube connection scope.
+func (c *Client) CreateSonarqubeConnectionScope(ctx context.Context, connectionId string, scope SonarqubeConnectionScope) (*SonarqubeConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s/scopes", c.HostURL, connectionId)
+	return createScope(ctx, c, url, scope)
+}
+
+// ReadSonarqubeConnectionScope - Reads a sonarqube connection scope.
+func (c *Client) ReadSonarqubeConnectionScope(ctx context.Context, connectionId, scopeId string) (*SonarqubeConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return readScope[SonarqubeConnectionScope](ctx, c, url)
+}
+
+// UpdateSonarqubeConnectionScope - Updates a sonarqube connection scope.
+func (c *Client) UpdateSonarqubeConnectionScope(ctx context.Context, connectionId, scopeId string, scope SonarqubeConnectionScope) (*SonarqubeConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return update(ctx, c, url, scope)
+}
+
+// DeleteSonarqubeConnectionScope - Deletes a sonarqube connection scope.
+func (c *Client) DeleteSonarqubeConnectionScope(ctx context.Context, connectionId, scopeId string) error {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s/scopes/%s", c.HostURL, connectionId, scopeId)
+	return del(ctx, c, url)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// REMOTE SCOPE
+////////////////////////////////////////////////////////////////////////////////
+
+// ListSonarqubeRemoteScopes - Lists all sonarqube projects, sonarqube has no
+// groups of projects.
+func (c *Client) ListSonarqubeRemoteScopes(ctx context.Context, connectionId string) ([]SonarqubeConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s/remote-scopes", c.HostURL, connectionId)
+	return listRemoteScopes[SonarqubeConnectionScope](ctx, c, url, "")
+}
+
+// SearchSonarqubeRemoteScopes - Searches sonarqube projects by name.
+func (c *Client) SearchSonarqubeRemoteScopes(ctx context.Context, connectionId, search string) ([]SonarqubeConnectionScope, error) {
+	url := fmt.Sprintf("%s/plugins/sonarqube/connections/%s/search-remote-scopes", c.HostURL, connectionId)
+	return searchRemoteScopes[SonarqubeConnectionScope](ctx, c, url, search)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListSonarqubeRemoteScopes(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("groupId"); got != "" {
+			t.Errorf("got groupId %q, want none", got)
+		}
+		fmt.Fprint(w, `{"children":[
+			{"type":"scope","id":"devlake","name":"DevLake","data":{"projectKey":"devlake","name":"DevLake","qualifier":"TRK","visibility":"public"}}
+		]}`)
+	})
+
+	scopes, err := c.ListSonarqubeRemoteScopes(t.Context(), "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(scopes) != 1 || scopes[0].ProjectKey != "devlake" || scopes[0].Visibility != "public" {
+		t.Errorf("unexpected scopes %+v", scopes)
+	}
+}
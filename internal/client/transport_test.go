@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTLSTestClient returns a client using a transport built from config
+// against the given TLS backend.
+func newTLSTestClient(t *testing.T, server *httptest.Server, config TransportConfig) *Client {
+	t.Helper()
+	transport, err := NewTransport(config)
+	if err != nil {
+		t.Fatalf("unexpected error creating transport: %s", err)
+	}
+	host := server.URL
+	c, err := NewClient(&host, BearerAuth{Token: "whatever"})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	c.HTTPClient.Transport = transport
+	c.MaxRetries = 0
+	return c
+}
+
+// certificatePEM returns the PEM encoding of a DER certificate.
+func certificatePEM(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// newClientCertificate returns a self-signed client certificate and its key,
+// PEM encoded.
+func newClientCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certificatePEM(der), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestTransportCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	untrusted := newTLSTestClient(t, server, TransportConfig{})
+	if _, err := read[struct{}](t.Context(), untrusted, untrusted.HostURL+"/projects/p"); err == nil {
+		t.Error("expected an error for an unknown certificate authority")
+	}
+
+	trusted := newTLSTestClient(t, server, TransportConfig{CACertPEM: certificatePEM(server.Certificate().Raw)})
+	if _, err := read[struct{}](t.Context(), trusted, trusted.HostURL+"/projects/p"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	insecure := newTLSTestClient(t, server, TransportConfig{InsecureSkipVerify: true})
+	if _, err := read[struct{}](t.Context(), insecure, insecure.HostURL+"/projects/p"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestTransportClientCert(t *testing.T) {
+	certPEM, keyPEM := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(certPEM)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	caCertPEM := certificatePEM(server.Certificate().Raw)
+
+	anonymous := newTLSTestClient(t, server, TransportConfig{CACertPEM: caCertPEM})
+	if _, err := read[struct{}](t.Context(), anonymous, anonymous.HostURL+"/projects/p"); err == nil {
+		t.Error("expected an error without client certificate")
+	}
+
+	authenticated := newTLSTestClient(t, server, TransportConfig{
+		CACertPEM:     caCertPEM,
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if _, err := read[struct{}](t.Context(), authenticated, authenticated.HostURL+"/projects/p"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+	requested := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- r.URL.String()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	transport, err := NewTransport(TransportConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatalf("unexpected error creating transport: %s", err)
+	}
+	host := "http://devlake.internal/api"
+	c, err := NewClient(&host, BearerAuth{Token: "whatever"})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	c.HTTPClient.Transport = transport
+
+	if _, err := read[struct{}](t.Context(), c, c.HostURL+"/projects/p"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := <-requested; got != "http://devlake.internal/api/projects/p" {
+		t.Errorf("proxy got request for %q", got)
+	}
+}
+
+func TestNewTransportInvalidConfig(t *testing.T) {
+	certPEM, keyPEM := newClientCertificate(t)
+
+	tests := map[string]TransportConfig{
+		"ca cert without certificate": {CACertPEM: []byte("not a certificate")},
+		"client cert without key":     {ClientCertPEM: certPEM},
+		"client key without cert":     {ClientKeyPEM: keyPEM},
+		"mismatching client key pair": {ClientCertPEM: certPEM, ClientKeyPEM: certPEM},
+		"proxy url without scheme":    {ProxyURL: "proxy.internal:3128"},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewTransport(config); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestDoRequestHeaders(t *testing.T) {
+	headers := make(chan http.Header, 1)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header
+		_, _ = w.Write([]byte(`{}`))
+	})
+	c.Headers = map[string]string{
+		"X-Forwarded-User": "terraform",
+		"Authorization":    "Bearer proxy",
+	}
+
+	if _, err := read[struct{}](t.Context(), c, c.HostURL+"/projects/p"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	header := <-headers
+	if got := header.Get("X-Forwarded-User"); got != "terraform" {
+		t.Errorf("X-Forwarded-User: got %q, want %q", got, "terraform")
+	}
+	if got := header.Get("Authorization"); got != "Bearer whatever" {
+		t.Errorf("Authorization: got %q, want %q", got, "Bearer whatever")
+	}
+}
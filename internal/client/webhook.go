@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// CONNECTION
+////////////////////////////////////////////////////////////////////////////////
+
+// CreateWebhookConnection - Creates new webhook connection. Only the response
+// contains the generated api key of the connection.
+func (c *Client) CreateWebhookConnection(ctx context.Context, connection WebhookConnection) (*WebhookConnection, error) {
+	url := fmt.Sprintf("%s/plugins/webhook/connections", c.HostURL)
+	return create(ctx, c, url, connection)
+}
+
+// ReadWebhookConnection - Returns webhook connection.
+func (c *Client) ReadWebhookConnection(ctx context.Context, id string) (*WebhookConnection, error) {
+	url := fmt.Sprintf("%s/plugins/webhook/connections/%s", c.HostURL, id)
+	return read[WebhookConnection](ctx, c, url)
+}
+
+// UpdateWebhookConnection - Updates webhook connection.
+func (c *Client) UpdateWebhookConnection(ctx context.Context, id string, connection WebhookConnection) (*WebhookConnection, error) {
+	url := fmt.Sprintf("%s/plugins/webhook/connections/%s", c.HostURL, id)
+	return update(ctx, c, url, connection)
+}
+
+// DeleteWebhookConnection - Deletes a webhook connection along with its api
+// key.
+func (c *Client) DeleteWebhookConnection(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/plugins/webhook/connections/%s", c.HostURL, id)
+	return del(ctx, c, url)
+}
+
+// WebhookConnectionUrl - Returns the url of a push endpoint of a webhook
+// connection, e.g. 'deployments'. Requests to it are authenticated with the api
+// key of the connection.
+func (c *Client) WebhookConnectionUrl(id int, endpoint string) string {
+	return fmt.Sprintf("%s/rest/plugins/webhook/connections/%d/%s", c.HostURL, id, endpoint)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCreateWebhookConnection(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/plugins/webhook/connections" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id":3,"name":"deploys","apiKey":{"id":7,"apiKey":"secret"}}`)
+	})
+
+	connection, err := c.CreateWebhookConnection(t.Context(), WebhookConnection{Name: "deploys"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if connection.ApiKey == nil || connection.ApiKey.ApiKey != "secret" {
+		t.Errorf("unexpected api key %+v", connection.ApiKey)
+	}
+	if want := c.HostURL + "/rest/plugins/webhook/connections/3/deployments"; c.WebhookConnectionUrl(connection.ID, "deployments") != want {
+		t.Errorf("got url %q, want %q", c.WebhookConnectionUrl(connection.ID, "deployments"), want)
+	}
+}
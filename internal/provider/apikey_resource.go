@@ -10,6 +10,7 @@ import (
 
 	"terraform-provider-devlake/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,13 +39,14 @@ type apiKeyResource struct {
 
 // apiKeyResourceModel maps the resource schema data.
 type apiKeyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	AllowedPath types.String `tfsdk:"allowed_path"`
-	ApiKey      types.String `tfsdk:"api_key"`
-	ExpiredAt   types.String `tfsdk:"expired_at"`
-	Name        types.String `tfsdk:"name"`
-	Type        types.String `tfsdk:"type"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	AllowedPath types.String   `tfsdk:"allowed_path"`
+	ApiKey      types.String   `tfsdk:"api_key"`
+	ExpiredAt   types.String   `tfsdk:"expired_at"`
+	Name        types.String   `tfsdk:"name"`
+	Type        types.String   `tfsdk:"type"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -53,7 +55,7 @@ func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema defines the schema for the resource.
-func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -101,6 +103,13 @@ func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -114,6 +123,14 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var apiKeyCreate = client.ApiKeyCreate{
 		AllowedPath: plan.AllowedPath.ValueString(),
@@ -123,7 +140,7 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create new apikey
-	apiKey, err := r.client.CreateApiKey(apiKeyCreate)
+	apiKey, err := r.client.CreateApiKey(ctx, apiKeyCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake apikey",
@@ -159,8 +176,16 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed apikey value from Devlake
-	apiKeys, err := r.client.ReadApiKeys()
+	apiKeys, err := r.client.ReadApiKeys(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read devlake apikeys",
@@ -170,6 +195,7 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Overwrite apikey with refreshed state
+	found := false
 	for _, apiKey := range apiKeys {
 		if types.StringValue(strconv.Itoa(apiKey.ID)) == state.ID {
 			state = apiKeyResourceModel{
@@ -178,10 +204,18 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 				ExpiredAt:   types.StringValue(apiKey.ExpiredAt),
 				Name:        types.StringValue(apiKey.Name),
 				Type:        types.StringValue(apiKey.Type),
+				Timeouts:    state.Timeouts,
 			}
+			found = true
 			break
 		}
 	}
+	if !found {
+		// Removed outside of terraform, drop it from the state so it gets
+		// recreated on the next apply.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -206,8 +240,16 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing apikey
-	err := r.client.DeleteApiKey(state.ID.ValueString())
+	err := r.client.DeleteApiKey(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting devlake apikey",
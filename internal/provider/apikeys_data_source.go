@@ -126,7 +126,7 @@ func (d *apiKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state apiKeysDataSourceModel
 
-	apiKeys, err := d.client.ReadApiKeys()
+	apiKeys, err := d.client.ReadApiKeys(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read devlake apikeys",
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &azureDevopsConnectionResource{}
+	_ resource.ResourceWithConfigure   = &azureDevopsConnectionResource{}
+	_ resource.ResourceWithImportState = &azureDevopsConnectionResource{}
+)
+
+// NewAzureDevopsConnectionResource is a helper function to simplify the provider implementation.
+func NewAzureDevopsConnectionResource() resource.Resource {
+	return &azureDevopsConnectionResource{}
+}
+
+// azureDevopsConnectionResource is the resource implementation.
+type azureDevopsConnectionResource struct {
+	client *client.Client
+}
+
+// azureDevopsConnectionResourceModel maps the resource schema data.
+type azureDevopsConnectionResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Endpoint         types.String   `tfsdk:"endpoint"`
+	Name             types.String   `tfsdk:"name"`
+	Organization     types.String   `tfsdk:"organization"`
+	Proxy            types.String   `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64    `tfsdk:"rate_limit_per_hour"`
+	Token            types.String   `tfsdk:"token"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *azureDevopsConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azuredevops_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *azureDevopsConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was created in devlake.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("https://dev.azure.com/"),
+				Description: "The base endpoint URL of azure devops. Defaults to 'https://dev.azure.com/'.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the azure devops connection.",
+				Required:    true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "The azure devops organization the repositories and pipelines belong to.",
+				Required:    true,
+			},
+			"proxy": schema.StringAttribute{
+				Computed:    true,
+				Description: "If you are behind a corporate firewall or VPN you may need to utilize a proxy server.",
+				Optional:    true,
+			},
+			"rate_limit_per_hour": schema.Int64Attribute{
+				Optional:    true,
+				Description: "DevLake uses a dynamic rate limit to collect Azure DevOps data. You can adjust the rate limit if you want to increase or lower the speed.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Personal access token (PAT) used for authentication, it needs read access to code and builds of the organization.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was updated in devlake.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *azureDevopsConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan azureDevopsConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	now := time.Now().Format(time.RFC850)
+
+	// Generate API request body from plan
+	var azureDevopsConnectionCreate = client.AzureDevopsConnection{
+		CreatedAt:        now,
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Organization:     plan.Organization.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		Token:            plan.Token.ValueString(),
+		UpdatedAt:        now,
+	}
+
+	// Create new azure devops connection
+	azureDevopsConnection, err := r.client.CreateAzureDevopsConnection(ctx, azureDevopsConnectionCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake azure devops connection",
+			"Could not create devlake azure devops connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(azureDevopsConnection.ID))
+	plan.LastUpdated = types.StringValue(now)
+	plan.CreatedAt = types.StringValue(azureDevopsConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(azureDevopsConnection.Endpoint)
+	plan.Name = types.StringValue(azureDevopsConnection.Name)
+	plan.Organization = types.StringValue(azureDevopsConnection.Organization)
+	plan.Proxy = types.StringValue(azureDevopsConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(azureDevopsConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(azureDevopsConnection.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *azureDevopsConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state azureDevopsConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed azure devops connection value from Devlake
+	azureDevopsConnection, err := r.client.ReadAzureDevopsConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake azure devops connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(azureDevopsConnection.ID))
+	state.CreatedAt = types.StringValue(azureDevopsConnection.CreatedAt)
+	state.Endpoint = types.StringValue(azureDevopsConnection.Endpoint)
+	state.Name = types.StringValue(azureDevopsConnection.Name)
+	state.Organization = types.StringValue(azureDevopsConnection.Organization)
+	state.Proxy = types.StringValue(azureDevopsConnection.Proxy)
+	state.RateLimitPerHour = types.Int64Value(int64(azureDevopsConnection.RateLimitPerHour))
+	state.UpdatedAt = types.StringValue(azureDevopsConnection.UpdatedAt)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *azureDevopsConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan azureDevopsConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake azure devops connection",
+			"Could not update devlake azure devops connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var azureDevopsConnectionUpdate = client.AzureDevopsConnection{
+		ID:               id,
+		CreatedAt:        plan.CreatedAt.ValueString(),
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Organization:     plan.Organization.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		Token:            plan.Token.ValueString(),
+		UpdatedAt:        time.Now().Format(time.RFC850),
+	}
+
+	// Update existing connection
+	updatedAzureDevopsConnection, err := r.client.UpdateAzureDevopsConnection(ctx, plan.ID.ValueString(), azureDevopsConnectionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake azure devops connection",
+			"Could not update devlake azure devops connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(updatedAzureDevopsConnection.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.StringValue(updatedAzureDevopsConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(updatedAzureDevopsConnection.Endpoint)
+	plan.Name = types.StringValue(updatedAzureDevopsConnection.Name)
+	plan.Organization = types.StringValue(updatedAzureDevopsConnection.Organization)
+	plan.Proxy = types.StringValue(updatedAzureDevopsConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(updatedAzureDevopsConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(updatedAzureDevopsConnection.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *azureDevopsConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state azureDevopsConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection
+	err := r.client.DeleteAzureDevopsConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake azure devops connection",
+			"Could not delete devlake azure devops connection, unexpected error: "+err.Error()+"..",
+		)
+		return
+	}
+}
+
+func (r *azureDevopsConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *azureDevopsConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
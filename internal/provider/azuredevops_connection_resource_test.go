@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	azureDevopsConnectionConfig = providerConfig + `
+resource "devlake_azuredevops_connection" "azdo" {
+  name         = "should_not_exist"
+  organization = "devlake"
+  token        = "whatever"
+}
+`
+)
+
+func TestAccAzureDevopsConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: azureDevopsConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "endpoint", "https://dev.azure.com/"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "organization", "devlake"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "id"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_azuredevops_connection.azdo",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_azuredevops_connection.azdo"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_azuredevops_connection.azdo not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"token", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_azuredevops_connection" "azdo" {
+  name         = "should_not_exist"
+  organization = "devlake2"
+  token        = "whatever"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "endpoint", "https://dev.azure.com/"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection.azdo", "organization", "devlake2"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "id"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection.azdo", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
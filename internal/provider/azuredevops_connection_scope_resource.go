@@ -0,0 +1,446 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &azureDevopsConnectionScopeResource{}
+	_ resource.ResourceWithConfigure   = &azureDevopsConnectionScopeResource{}
+	_ resource.ResourceWithImportState = &azureDevopsConnectionScopeResource{}
+)
+
+// NewAzureDevopsConnectionScopeResource is a helper function to simplify the provider implementation.
+func NewAzureDevopsConnectionScopeResource() resource.Resource {
+	return &azureDevopsConnectionScopeResource{}
+}
+
+// azureDevopsConnectionScopeResource is the resource implementation.
+type azureDevopsConnectionScopeResource struct {
+	client *client.Client
+}
+
+// azureDevopsConnectionScopeResourceModel maps the resource schema data.
+type azureDevopsConnectionScopeResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	ConnectionId   types.String   `tfsdk:"connection_id"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+	IsFork         types.Bool     `tfsdk:"is_fork"`
+	IsPrivate      types.Bool     `tfsdk:"is_private"`
+	Name           types.String   `tfsdk:"name"`
+	OrganizationId types.String   `tfsdk:"organization_id"`
+	ProjectId      types.String   `tfsdk:"project_id"`
+	RemoteUrl      types.String   `tfsdk:"remote_url"`
+	ScopeConfigId  types.String   `tfsdk:"scope_config_id"`
+	Type           types.String   `tfsdk:"type"`
+	Url            types.String   `tfsdk:"url"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *azureDevopsConnectionScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azuredevops_connection_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *azureDevopsConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id (a GUID) of the azure devops repository, its pipelines are collected along with it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection this scope is part of.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_fork": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the repository is a fork. Defaults to 'false'.",
+				Optional:    true,
+			},
+			"is_private": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the repository is private. Defaults to 'false'.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the repository.",
+				Required:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The azure devops organization of the repository. Defaults to the organization of the connection.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The name of the azure devops project containing the repository.",
+				Required:    true,
+			},
+			"remote_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The url used to clone the repository. Defaults to 'url'.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for the scope. Needs to be created first.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("TfsGit"),
+				Description: "The type of the repository, 'TfsGit' for Azure Repos. Defaults to 'TfsGit'.",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The web url of the repository. Defaults to the url derived from the endpoint of the connection, the organization, the project and the name.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *azureDevopsConnectionScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan azureDevopsConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	azureDevopsConnectionScopeCreate, err := r.scopeFromModel(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake azure devops connection scope",
+			"Could not create devlake azure devops connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC3339)
+	azureDevopsConnectionScopeCreate.CreatedAt = now
+	azureDevopsConnectionScopeCreate.UpdatedAt = now
+
+	// Create new azure devops connection scope
+	azureDevopsConnectionScope, err := r.client.CreateAzureDevopsConnectionScope(ctx, plan.ConnectionId.ValueString(), *azureDevopsConnectionScopeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake azure devops connection scope",
+			"Could not create devlake azure devops connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	azureDevopsConnectionScopeToModel(azureDevopsConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *azureDevopsConnectionScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state azureDevopsConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed azure devops connection scope value from Devlake
+	azureDevopsConnectionScope, err := r.client.ReadAzureDevopsConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake azure devops connection scope",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection scope with refreshed state
+	azureDevopsConnectionScopeToModel(azureDevopsConnectionScope, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *azureDevopsConnectionScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan azureDevopsConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	azureDevopsConnectionScopeUpdate, err := r.scopeFromModel(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake azure devops connection scope",
+			"Could not update devlake azure devops connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	azureDevopsConnectionScopeUpdate.CreatedAt = plan.CreatedAt.ValueString()
+	azureDevopsConnectionScopeUpdate.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	// Update existing connection scope
+	updatedAzureDevopsConnectionScope, err := r.client.UpdateAzureDevopsConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), *azureDevopsConnectionScopeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake azure devops connection scope",
+			"Could not update devlake azure devops connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	azureDevopsConnectionScopeToModel(updatedAzureDevopsConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *azureDevopsConnectionScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state azureDevopsConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope
+	err := r.client.DeleteAzureDevopsConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake azure devops connection scope",
+			"Could not delete devlake azure devops connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *azureDevopsConnectionScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scope_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// scopeFromModel generates the API request body from the plan. The
+// organization and urls of the repository are derived from the connection
+// unless they are configured.
+func (r *azureDevopsConnectionScopeResource) scopeFromModel(ctx context.Context, plan azureDevopsConnectionScopeResourceModel) (*client.AzureDevopsConnectionScope, error) {
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	organizationId := plan.OrganizationId.ValueString()
+	repoUrl := plan.Url.ValueString()
+	if !isKnown(plan.OrganizationId) || !isKnown(plan.Url) {
+		azureDevopsConnection, err := r.client.ReadAzureDevopsConnection(ctx, plan.ConnectionId.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if !isKnown(plan.OrganizationId) {
+			organizationId = azureDevopsConnection.Organization
+		}
+		if !isKnown(plan.Url) {
+			repoUrl = fmt.Sprintf("%s/%s/%s/_git/%s",
+				strings.TrimSuffix(azureDevopsConnection.Endpoint, "/"),
+				url.PathEscape(organizationId),
+				url.PathEscape(plan.ProjectId.ValueString()),
+				url.PathEscape(plan.Name.ValueString()),
+			)
+		}
+	}
+	remoteUrl := plan.RemoteUrl.ValueString()
+	if !isKnown(plan.RemoteUrl) {
+		remoteUrl = repoUrl
+	}
+
+	return &client.AzureDevopsConnectionScope{
+		ConnectionId:   connectionId,
+		ID:             plan.ID.ValueString(),
+		IsFork:         plan.IsFork.ValueBool(),
+		IsPrivate:      plan.IsPrivate.ValueBool(),
+		Name:           plan.Name.ValueString(),
+		OrganizationId: organizationId,
+		ProjectId:      plan.ProjectId.ValueString(),
+		RemoteUrl:      remoteUrl,
+		ScopeConfigId:  scopeConfigId,
+		Type:           plan.Type.ValueString(),
+		Url:            repoUrl,
+	}, nil
+}
+
+// azureDevopsConnectionScopeToModel maps an azure devops connection scope
+// returned by devlake to the resource model.
+func azureDevopsConnectionScopeToModel(scope *client.AzureDevopsConnectionScope, model *azureDevopsConnectionScopeResourceModel) {
+	model.ID = types.StringValue(scope.ID)
+	model.ConnectionId = types.StringValue(strconv.Itoa(scope.ConnectionId))
+	model.CreatedAt = types.StringValue(scope.CreatedAt)
+	model.IsFork = types.BoolValue(scope.IsFork)
+	model.IsPrivate = types.BoolValue(scope.IsPrivate)
+	model.Name = types.StringValue(scope.Name)
+	model.OrganizationId = types.StringValue(scope.OrganizationId)
+	model.ProjectId = types.StringValue(scope.ProjectId)
+	model.RemoteUrl = types.StringValue(scope.RemoteUrl)
+	model.ScopeConfigId = types.StringValue(strconv.Itoa(scope.ScopeConfigId))
+	model.Type = types.StringValue(scope.Type)
+	model.Url = types.StringValue(scope.Url)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *azureDevopsConnectionScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	azureDevopsConnectionScopeConfig = azureDevopsConnectionScopeConfigConfig + `
+resource "devlake_azuredevops_connection_scope" "scope" {
+  id = "0d50ba13-f9ad-49b0-9b21-d29eda50ca33"
+  connection_id	= devlake_azuredevops_connection.azdo.id
+  name = "repo"
+  project_id = "project"
+  scope_config_id = devlake_azuredevops_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccAzureDevopsConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: azureDevopsConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "id", "0d50ba13-f9ad-49b0-9b21-d29eda50ca33"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "is_fork", "false"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "is_private", "false"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "name", "repo"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "organization_id", "devlake"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "project_id", "project"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "remote_url", "https://dev.azure.com/devlake/project/_git/repo"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "type", "TfsGit"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "url", "https://dev.azure.com/devlake/project/_git/repo"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_azuredevops_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_azuredevops_connection.azdo"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_azuredevops_connection.azdo not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_azuredevops_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_azuredevops_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "scope_config_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: azureDevopsConnectionScopeConfigConfig + `
+resource "devlake_azuredevops_connection_scope" "scope" {
+  id = "0d50ba13-f9ad-49b0-9b21-d29eda50ca33"
+  connection_id	= devlake_azuredevops_connection.azdo.id
+  is_private = true
+  name = "repo"
+  project_id = "project"
+  scope_config_id = devlake_azuredevops_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "id", "0d50ba13-f9ad-49b0-9b21-d29eda50ca33"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "is_fork", "false"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "is_private", "true"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "name", "repo"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "organization_id", "devlake"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "project_id", "project"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "remote_url", "https://dev.azure.com/devlake/project/_git/repo"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "type", "TfsGit"),
+					resource.TestCheckResourceAttr("devlake_azuredevops_connection_scope.scope", "url", "https://dev.azure.com/devlake/project/_git/repo"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_azuredevops_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
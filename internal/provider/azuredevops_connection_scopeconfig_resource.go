@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &azureDevopsConnectionScopeConfigResource{}
+	_ resource.ResourceWithConfigure   = &azureDevopsConnectionScopeConfigResource{}
+	_ resource.ResourceWithImportState = &azureDevopsConnectionScopeConfigResource{}
+)
+
+// NewAzureDevopsConnectionScopeConfigResource is a helper function to simplify the provider implementation.
+func NewAzureDevopsConnectionScopeConfigResource() resource.Resource {
+	return &azureDevopsConnectionScopeConfigResource{}
+}
+
+// azureDevopsConnectionScopeConfigResource is the resource implementation.
+type azureDevopsConnectionScopeConfigResource struct {
+	client *client.Client
+}
+
+// azureDevopsConnectionScopeConfigResourceModel maps the resource schema data.
+type azureDevopsConnectionScopeConfigResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+	ConnectionId      types.String   `tfsdk:"connection_id"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	DeploymentPattern types.String   `tfsdk:"deployment_pattern"`
+	Entities          types.List     `tfsdk:"entities"`
+	Name              types.String   `tfsdk:"name"`
+	ProductionPattern types.String   `tfsdk:"production_pattern"`
+	RefDiff           *refDiff       `tfsdk:"ref_diff"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *azureDevopsConnectionScopeConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azuredevops_connection_scopeconfig"
+}
+
+// Schema defines the schema for the resource.
+func (r *azureDevopsConnectionScopeConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection scopeconfig. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the scope config.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The connection id of the connection this scope config belongs to.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope config was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(deploy|push-image)"),
+				Description: "Convert an Azure Pipelines run as a DevLake Deployment when: The name of the pipeline or one of its jobs matches this pattern. Defaults to '(deploy|push-image)'.",
+				Optional:    true,
+			},
+			"entities": schema.ListAttribute{
+				Computed:    true,
+				Description: "The entities this scope config uses, e.g. 'CODE', 'CODEREVIEW', 'CICD' or 'CROSS'. See the documentation for the meaning of the individual values.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Default: listdefault.StaticValue(types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("CODE"),
+						types.StringValue("CODEREVIEW"),
+						types.StringValue("CICD"),
+						types.StringValue("CROSS"),
+					},
+				)),
+			},
+			"ref_diff": schema.SingleNestedAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Calculate the commits diff between two consecutive tags that match the following RegEx. Issues closed by PRs which contain these commits will also be calculated. The result will be shown in table.refs_commits_diffs and table.refs_issues_diffs.",
+				Default: objectdefault.StaticValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"tags_limit":   types.Int64Type,
+						"tags_pattern": types.StringType,
+					},
+					map[string]attr.Value{
+						"tags_limit":   types.Int64Value(10),
+						"tags_pattern": types.StringValue(`/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+					},
+				)),
+				Attributes: map[string]schema.Attribute{
+					"tags_limit": schema.Int64Attribute{
+						Computed:    true,
+						Default:     int64default.StaticInt64(10),
+						Description: "Compare the last number of tags.",
+						Optional:    true,
+					},
+					"tags_pattern": schema.StringAttribute{
+						Computed:    true,
+						Default:     stringdefault.StaticString(`/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+						Description: "Matching tags are included in the calculation.",
+						Optional:    true,
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the scope config.",
+				Required:    true,
+			},
+			"production_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(prod|release)"),
+				Description: "Convert an Azure Pipelines run as a DevLake Deployment when: If the name also matches this pattern, this deployment is a 'Production Deployment'. Use only with 'deployment_pattern'. Defaults to '(prod|release)'.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *azureDevopsConnectionScopeConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan azureDevopsConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake azure devops connection scopeconfig",
+			"Could not create devlake azure devops connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var azureDevopsConnectionScopeConfigCreate = client.AzureDevopsConnectionScopeConfig{
+		ConnectionId: connectionId,
+		RefDiff: &client.RefDiff{
+			TagsLimit:   int(plan.RefDiff.TagsLimit.ValueInt64()),
+			TagsPattern: plan.RefDiff.TagsPattern.ValueString(),
+		},
+		DeploymentPattern: plan.DeploymentPattern.ValueString(),
+		Entities:          entities,
+		Name:              plan.Name.ValueString(),
+		ProductionPattern: plan.ProductionPattern.ValueString(),
+	}
+
+	// Create new azure devops connection scope config
+	azureDevopsConnectionScopeConfig, err := r.client.CreateAzureDevopsConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), azureDevopsConnectionScopeConfigCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake azure devops connection scope config",
+			"Could not create devlake azure devops connection scope config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, azureDevopsConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Entities = entitiesVal
+	plan.ID = types.StringValue(strconv.Itoa(azureDevopsConnectionScopeConfig.ID))
+	plan.CreatedAt = types.StringValue(azureDevopsConnectionScopeConfig.CreatedAt)
+	plan.DeploymentPattern = types.StringValue(azureDevopsConnectionScopeConfig.DeploymentPattern)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Name = types.StringValue(azureDevopsConnectionScopeConfig.Name)
+	plan.ProductionPattern = types.StringValue(azureDevopsConnectionScopeConfig.ProductionPattern)
+	plan.RefDiff.TagsLimit = types.Int64Value(int64(azureDevopsConnectionScopeConfig.RefDiff.TagsLimit))
+	plan.RefDiff.TagsPattern = types.StringValue(azureDevopsConnectionScopeConfig.RefDiff.TagsPattern)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *azureDevopsConnectionScopeConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state azureDevopsConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed azure devops connection scope config value from Devlake
+	azureDevopsConnectionScopeConfig, err := r.client.ReadAzureDevopsConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake azure devops connection scopeconfig",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite azure devops connection scope config with refreshed state
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, azureDevopsConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CreatedAt = types.StringValue(azureDevopsConnectionScopeConfig.CreatedAt)
+	state.DeploymentPattern = types.StringValue(azureDevopsConnectionScopeConfig.DeploymentPattern)
+	state.Entities = entitiesVal
+	state.Name = types.StringValue(azureDevopsConnectionScopeConfig.Name)
+	state.ProductionPattern = types.StringValue(azureDevopsConnectionScopeConfig.ProductionPattern)
+	if apiRefDiff := azureDevopsConnectionScopeConfig.RefDiff; apiRefDiff != nil {
+		state.RefDiff = &refDiff{
+			TagsLimit:   types.Int64Value(int64(apiRefDiff.TagsLimit)),
+			TagsPattern: types.StringValue(apiRefDiff.TagsPattern),
+		}
+	} else {
+		state.RefDiff = nil
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *azureDevopsConnectionScopeConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan azureDevopsConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake azure devops connection scopeconfig",
+			"Could not update devlake azure devops connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake azure devops connection scopeconfig",
+			"Could not update devlake azure devops connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	var azureDevopsConnectionScopeConfigUpdate = client.AzureDevopsConnectionScopeConfig{
+		ConnectionId:      connectionId,
+		ID:                id,
+		DeploymentPattern: plan.DeploymentPattern.ValueString(),
+		Entities:          entities,
+		Name:              plan.Name.ValueString(),
+		ProductionPattern: plan.ProductionPattern.ValueString(),
+		RefDiff: &client.RefDiff{
+			TagsLimit:   int(plan.RefDiff.TagsLimit.ValueInt64()),
+			TagsPattern: plan.RefDiff.TagsPattern.ValueString(),
+		},
+	}
+
+	// Update existing connection scope config
+	updatedAzureDevopsConnectionScopeConfig, err := r.client.UpdateAzureDevopsConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), azureDevopsConnectionScopeConfigUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake azure devops connection scopeconfig",
+			"Could not update devlake azure devops connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, updatedAzureDevopsConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.CreatedAt = types.StringValue(updatedAzureDevopsConnectionScopeConfig.CreatedAt)
+	plan.DeploymentPattern = types.StringValue(updatedAzureDevopsConnectionScopeConfig.DeploymentPattern)
+	plan.Entities = entitiesVal
+	plan.Name = types.StringValue(updatedAzureDevopsConnectionScopeConfig.Name)
+	plan.ProductionPattern = types.StringValue(updatedAzureDevopsConnectionScopeConfig.ProductionPattern)
+	plan.RefDiff.TagsLimit = types.Int64Value(int64(updatedAzureDevopsConnectionScopeConfig.RefDiff.TagsLimit))
+	plan.RefDiff.TagsPattern = types.StringValue(updatedAzureDevopsConnectionScopeConfig.RefDiff.TagsPattern)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *azureDevopsConnectionScopeConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state azureDevopsConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope config
+	err := r.client.DeleteAzureDevopsConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake azure devops connection scopeconfig",
+			"Could not delete devlake azure devops connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *azureDevopsConnectionScopeConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scopeconfig_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *azureDevopsConnectionScopeConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
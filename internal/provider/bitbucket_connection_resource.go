@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bitbucketConnectionResource{}
+	_ resource.ResourceWithConfigure   = &bitbucketConnectionResource{}
+	_ resource.ResourceWithImportState = &bitbucketConnectionResource{}
+)
+
+// NewBitbucketConnectionResource is a helper function to simplify the provider implementation.
+func NewBitbucketConnectionResource() resource.Resource {
+	return &bitbucketConnectionResource{}
+}
+
+// bitbucketConnectionResource is the resource implementation.
+type bitbucketConnectionResource struct {
+	client *client.Client
+}
+
+// bitbucketConnectionResourceModel maps the resource schema data.
+type bitbucketConnectionResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Endpoint         types.String   `tfsdk:"endpoint"`
+	Name             types.String   `tfsdk:"name"`
+	Password         types.String   `tfsdk:"password"`
+	Proxy            types.String   `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64    `tfsdk:"rate_limit_per_hour"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Username         types.String   `tfsdk:"username"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *bitbucketConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bitbucket_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *bitbucketConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was created in devlake.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("https://api.bitbucket.org/2.0/"),
+				Description: "The base endpoint URL. Defaults to 'https://api.bitbucket.org/2.0/'.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the bitbucket cloud connection.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "App password of the bitbucket user, the following permissions are required to collect data from Bitbucket repositories: Account read, Workspace membership read, Projects read, Repositories read, Pull requests read, Issues read, Pipelines read.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"proxy": schema.StringAttribute{
+				Computed:    true,
+				Description: "If you are behind a corporate firewall or VPN you may need to utilize a proxy server.",
+				Optional:    true,
+			},
+			"rate_limit_per_hour": schema.Int64Attribute{
+				Optional:    true,
+				Description: "DevLake uses a dynamic rate limit to collect Bitbucket Cloud data. You can adjust the rate limit if you want to increase or lower the speed.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was updated in devlake.",
+			},
+			"username": schema.StringAttribute{
+				Description: "The bitbucket username, not the email address.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *bitbucketConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan bitbucketConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	now := time.Now().Format(time.RFC850)
+
+	// Generate API request body from plan
+	var bitbucketConnectionCreate = client.BitbucketConnection{
+		CreatedAt:        now,
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Password:         plan.Password.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		UpdatedAt:        now,
+		Username:         plan.Username.ValueString(),
+	}
+
+	// Create new bitbucketconnection
+	bitbucketConnection, err := r.client.CreateBitbucketConnection(ctx, bitbucketConnectionCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket cloud connection",
+			"Could not create devlake bitbucket cloud connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(bitbucketConnection.ID))
+	plan.LastUpdated = types.StringValue(now)
+	plan.CreatedAt = types.StringValue(bitbucketConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(bitbucketConnection.Endpoint)
+	plan.Name = types.StringValue(bitbucketConnection.Name)
+	plan.Proxy = types.StringValue(bitbucketConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(bitbucketConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(bitbucketConnection.UpdatedAt)
+	plan.Username = types.StringValue(bitbucketConnection.Username)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bitbucketConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state bitbucketConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed bitbucket cloud connection value from Devlake
+	bitbucketConnection, err := r.client.ReadBitbucketConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake bitbucket cloud connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(bitbucketConnection.ID))
+	state.CreatedAt = types.StringValue(bitbucketConnection.CreatedAt)
+	state.Endpoint = types.StringValue(bitbucketConnection.Endpoint)
+	state.Name = types.StringValue(bitbucketConnection.Name)
+	state.Proxy = types.StringValue(bitbucketConnection.Proxy)
+	state.RateLimitPerHour = types.Int64Value(int64(bitbucketConnection.RateLimitPerHour))
+	state.UpdatedAt = types.StringValue(bitbucketConnection.UpdatedAt)
+	state.Username = types.StringValue(bitbucketConnection.Username)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *bitbucketConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan bitbucketConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake bitbucket cloud connection",
+			"Could not update devlake bitbucket cloud connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var bitbucketConnectionUpdate = client.BitbucketConnection{
+		ID:               id,
+		CreatedAt:        plan.CreatedAt.ValueString(),
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Password:         plan.Password.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		UpdatedAt:        time.Now().Format(time.RFC850),
+		Username:         plan.Username.ValueString(),
+	}
+
+	// Update existing connection
+	updatedBitbucketConnection, err := r.client.UpdateBitbucketConnection(ctx, plan.ID.ValueString(), bitbucketConnectionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake bitbucket cloud connection",
+			"Could not update devlake bitbucket cloud connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(updatedBitbucketConnection.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.StringValue(updatedBitbucketConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(updatedBitbucketConnection.Endpoint)
+	plan.Name = types.StringValue(updatedBitbucketConnection.Name)
+	plan.Proxy = types.StringValue(updatedBitbucketConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(updatedBitbucketConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(updatedBitbucketConnection.UpdatedAt)
+	plan.Username = types.StringValue(updatedBitbucketConnection.Username)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bitbucketConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state bitbucketConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing apikey
+	err := r.client.DeleteBitbucketConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake bitbucket cloud connection",
+			"Could not delete devlake bitbucket cloud connection, unexpected error: "+err.Error()+"..",
+		)
+		return
+	}
+}
+
+func (r *bitbucketConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bitbucketConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
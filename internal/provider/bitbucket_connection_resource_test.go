@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	bitbucketConnectionConfig = providerConfig + `
+resource "devlake_bitbucket_connection" "bbcloud" {
+  name      = "should_not_exist"
+  password  = "whatever"
+  username  = "serviceAccount"
+}
+`
+)
+
+func TestAccBitbucketConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: bitbucketConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "endpoint", "https://api.bitbucket.org/2.0/"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "password", "whatever"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "username", "serviceAccount"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_bitbucket_connection.bbcloud",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_bitbucket_connection.bbcloud"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_bitbucket_connection.bbcloud not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"password", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_bitbucket_connection" "bbcloud" {
+  name      = "should_not_exist2"
+  password  = "whatever"
+  username  = "serviceAccount"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "endpoint", "https://api.bitbucket.org/2.0/"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "name", "should_not_exist2"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "password", "whatever"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection.bbcloud", "username", "serviceAccount"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection.bbcloud", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
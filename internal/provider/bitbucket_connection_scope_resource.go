@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bitbucketConnectionScopeResource{}
+	_ resource.ResourceWithConfigure   = &bitbucketConnectionScopeResource{}
+	_ resource.ResourceWithImportState = &bitbucketConnectionScopeResource{}
+)
+
+// NewBitbucketConnectionScopeResource is a helper function to simplify the provider implementation.
+func NewBitbucketConnectionScopeResource() resource.Resource {
+	return &bitbucketConnectionScopeResource{}
+}
+
+// bitbucketConnectionScopeResource is the resource implementation.
+type bitbucketConnectionScopeResource struct {
+	client *client.Client
+}
+
+// bitbucketConnectionScopeResourceModel maps the resource schema data.
+type bitbucketConnectionScopeResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	CloneUrl      types.String   `tfsdk:"clone_url"`
+	ConnectionId  types.String   `tfsdk:"connection_id"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Description   types.String   `tfsdk:"description"`
+	HTMLUrl       types.String   `tfsdk:"html_url"`
+	Name          types.String   `tfsdk:"name"`
+	ScopeConfigId types.String   `tfsdk:"scope_config_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *bitbucketConnectionScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bitbucket_connection_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *bitbucketConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Bitbucket workspace and repository in the format '<WORKSPACE>/<REPOSITORY>'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"clone_url": schema.StringAttribute{
+				Description: "The Bitbucket https clone url.",
+				Required:    true,
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection this scope is part of.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for the connection scope.",
+				Required:    true,
+			},
+			"html_url": schema.StringAttribute{
+				Description: "The Bitbucket HTML url, e.g. 'https://bitbucket.org/<WORKSPACE>/<REPOSITORY>'.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "A name for the connection scope.",
+				Required:    true,
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for the scope. Needs to be created first.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *bitbucketConnectionScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan bitbucketConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket cloud connection scope",
+			"Could not create devlake bitbucket cloud connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket cloud connection scope",
+			"Could not create devlake bitbucket cloud connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC3339)
+	var bitbucketConnectionScopeCreate = client.BitbucketConnectionScope{
+		BitbucketId:   plan.ID.ValueString(),
+		CloneUrl:      plan.CloneUrl.ValueString(),
+		ConnectionId:  connectionId,
+		CreatedAt:     now,
+		Description:   plan.Description.ValueString(),
+		HTMLUrl:       plan.HTMLUrl.ValueString(),
+		Name:          plan.Name.ValueString(),
+		ScopeConfigId: scopeConfigId,
+		UpdatedAt:     now,
+	}
+
+	// Create new bitbucketconnectionscope
+	bitbucketConnectionScope, err := r.client.CreateBitbucketConnectionScope(ctx, plan.ConnectionId.ValueString(), bitbucketConnectionScopeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket cloud connection scope",
+			"Could not create devlake bitbucket cloud connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(bitbucketConnectionScope.BitbucketId)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	plan.CloneUrl = types.StringValue(bitbucketConnectionScope.CloneUrl)
+	plan.ConnectionId = types.StringValue(strconv.Itoa(bitbucketConnectionScope.ConnectionId))
+	plan.CreatedAt = types.StringValue(bitbucketConnectionScope.CreatedAt)
+	plan.Description = types.StringValue(bitbucketConnectionScope.Description)
+	plan.HTMLUrl = types.StringValue(bitbucketConnectionScope.HTMLUrl)
+	plan.Name = types.StringValue(bitbucketConnectionScope.Name)
+	plan.ScopeConfigId = types.StringValue(strconv.Itoa(bitbucketConnectionScope.ScopeConfigId))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bitbucketConnectionScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state bitbucketConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed bitbucket cloud connection scope value from Devlake
+	bitbucketConnectionScope, err := r.client.ReadBitbucketConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake bitbucket cloud connection scope",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	state.ID = types.StringValue(bitbucketConnectionScope.BitbucketId)
+	state.CloneUrl = types.StringValue(bitbucketConnectionScope.CloneUrl)
+	state.ConnectionId = types.StringValue(strconv.Itoa(bitbucketConnectionScope.ConnectionId))
+	state.CreatedAt = types.StringValue(bitbucketConnectionScope.CreatedAt)
+	state.Description = types.StringValue(bitbucketConnectionScope.Description)
+	state.HTMLUrl = types.StringValue(bitbucketConnectionScope.HTMLUrl)
+	state.Name = types.StringValue(bitbucketConnectionScope.Name)
+	state.ScopeConfigId = types.StringValue(strconv.Itoa(bitbucketConnectionScope.ScopeConfigId))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *bitbucketConnectionScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan bitbucketConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake bitbucket cloud connection scope",
+			"Could not update devlake bitbucket cloud connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket cloud connection scope",
+			"Could not create devlake bitbucket cloud connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var bitbucketConnectionScopeUpdate = client.BitbucketConnectionScope{
+		BitbucketId:   plan.ID.ValueString(),
+		CloneUrl:      plan.CloneUrl.ValueString(),
+		ConnectionId:  connectionId,
+		CreatedAt:     plan.CreatedAt.ValueString(),
+		Description:   plan.Description.ValueString(),
+		HTMLUrl:       plan.HTMLUrl.ValueString(),
+		Name:          plan.Name.ValueString(),
+		ScopeConfigId: scopeConfigId,
+		UpdatedAt:     time.Now().Format(time.RFC3339),
+	}
+
+	// Update existing connection scope
+	updatedBitbucketConnectionScope, err := r.client.UpdateBitbucketConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), bitbucketConnectionScopeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake bitbucket cloud connection scope",
+			"Could not update devlake bitbucket cloud connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(updatedBitbucketConnectionScope.BitbucketId)
+	plan.CloneUrl = types.StringValue(updatedBitbucketConnectionScope.CloneUrl)
+	plan.ConnectionId = types.StringValue(strconv.Itoa(updatedBitbucketConnectionScope.ConnectionId))
+	plan.CreatedAt = types.StringValue(updatedBitbucketConnectionScope.CreatedAt)
+	plan.Description = types.StringValue(updatedBitbucketConnectionScope.Description)
+	plan.HTMLUrl = types.StringValue(updatedBitbucketConnectionScope.HTMLUrl)
+	plan.Name = types.StringValue(updatedBitbucketConnectionScope.Name)
+	plan.ScopeConfigId = types.StringValue(strconv.Itoa(updatedBitbucketConnectionScope.ScopeConfigId))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bitbucketConnectionScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state bitbucketConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing apikey
+	err := r.client.DeleteBitbucketConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake bitbucket cloud connection scope",
+			"Could not delete devlake bitbucket cloud connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *bitbucketConnectionScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scope_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bitbucketConnectionScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	bitbucketConnectionScopeConfig = bitbucketConnectionScopeConfigConfig + `
+resource "devlake_bitbucket_connection_scope" "scope" {
+  id = "workspace/repo"
+  clone_url = "https://bitbucket.org/workspace/repo.git"
+  connection_id	= devlake_bitbucket_connection.bbcloud.id
+  description = "example repo"
+  html_url = "https://bitbucket.org/workspace/repo"
+  name = "workspace/repo"
+  scope_config_id = devlake_bitbucket_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccBitbucketConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: bitbucketConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "clone_url", "https://bitbucket.org/workspace/repo.git"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "description", "example repo"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "html_url", "https://bitbucket.org/workspace/repo"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "name", "workspace/repo"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "id", "workspace/repo"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_bitbucket_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_bitbucket_connection.bbcloud"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_bitbucket_connection.bbcloud not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_bitbucket_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_bitbucket_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "scope_config_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: bitbucketConnectionScopeConfigConfig + `
+resource "devlake_bitbucket_connection_scope" "scope" {
+  id = "workspace/repo"
+  clone_url = "https://bitbucket.org/workspace/repo.git"
+  connection_id	= devlake_bitbucket_connection.bbcloud.id
+  description = "example repo"
+  html_url = "https://bitbucket.org/workspace/repo2"
+  name = "workspace/repo2"
+  scope_config_id = devlake_bitbucket_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "clone_url", "https://bitbucket.org/workspace/repo.git"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "description", "example repo"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "html_url", "https://bitbucket.org/workspace/repo2"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "name", "workspace/repo2"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scope.scope", "id", "workspace/repo"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
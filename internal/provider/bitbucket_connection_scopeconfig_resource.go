@@ -0,0 +1,507 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bitbucketConnectionScopeConfigResource{}
+	_ resource.ResourceWithConfigure   = &bitbucketConnectionScopeConfigResource{}
+	_ resource.ResourceWithImportState = &bitbucketConnectionScopeConfigResource{}
+)
+
+// NewBitbucketConnectionScopeConfigResource is a helper function to simplify the provider implementation.
+func NewBitbucketConnectionScopeConfigResource() resource.Resource {
+	return &bitbucketConnectionScopeConfigResource{}
+}
+
+// bitbucketConnectionScopeConfigResource is the resource implementation.
+type bitbucketConnectionScopeConfigResource struct {
+	client *client.Client
+}
+
+// bitbucketConnectionScopeConfigResourceModel maps the resource schema data.
+type bitbucketConnectionScopeConfigResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	LastUpdated           types.String   `tfsdk:"last_updated"`
+	ConnectionId          types.String   `tfsdk:"connection_id"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+	DeploymentPattern     types.String   `tfsdk:"deployment_pattern"`
+	Entities              types.List     `tfsdk:"entities"`
+	IssueStatusDone       types.String   `tfsdk:"issue_status_done"`
+	IssueStatusInProgress types.String   `tfsdk:"issue_status_in_progress"`
+	IssueStatusOther      types.String   `tfsdk:"issue_status_other"`
+	IssueStatusTodo       types.String   `tfsdk:"issue_status_todo"`
+	Name                  types.String   `tfsdk:"name"`
+	ProductionPattern     types.String   `tfsdk:"production_pattern"`
+	RefDiff               *refDiff       `tfsdk:"ref_diff"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *bitbucketConnectionScopeConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bitbucket_connection_scopeconfig"
+}
+
+// Schema defines the schema for the resource.
+func (r *bitbucketConnectionScopeConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection scopeconfig. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the scope config.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The connection id of the connection this scope config belongs to.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope config was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(deploy|push-image)"),
+				Description: "Convert a Bitbucket Pipelines run as a DevLake Deployment when: The name of one of its steps or its environment matches this pattern. Defaults to '(deploy|push-image)'.",
+				Optional:    true,
+			},
+			"entities": schema.ListAttribute{
+				Computed:    true,
+				Description: "The entities this scope config uses, e.g. 'CODE', 'TICKET', 'CODEREVIEW', 'CROSS' or 'CICD'. See the documentation for the meaning of the individual values.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Default: listdefault.StaticValue(types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("CODE"),
+						types.StringValue("TICKET"),
+						types.StringValue("CODEREVIEW"),
+						types.StringValue("CROSS"),
+						types.StringValue("CICD"),
+					},
+				)),
+			},
+			"ref_diff": schema.SingleNestedAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Calculate the commits diff between two consecutive tags that match the following RegEx. Issues closed by PRs which contain these commits will also be calculated. The result will be shown in table.refs_commits_diffs and table.refs_issues_diffs.",
+				Default: objectdefault.StaticValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"tags_limit":   types.Int64Type,
+						"tags_pattern": types.StringType,
+					},
+					map[string]attr.Value{
+						"tags_limit":   types.Int64Value(10),
+						"tags_pattern": types.StringValue(`/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+					},
+				)),
+				Attributes: map[string]schema.Attribute{
+					"tags_limit": schema.Int64Attribute{
+						Computed:    true,
+						Default:     int64default.StaticInt64(10),
+						Description: "Compare the last number of tags.",
+						Optional:    true,
+					},
+					"tags_pattern": schema.StringAttribute{
+						Computed:    true,
+						Default:     stringdefault.StaticString(`/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+						Description: "Matching tags are included in the calculation.",
+						Optional:    true,
+					},
+				},
+			},
+			"issue_status_done": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("closed"),
+				Description: "Comma-separated bitbucket issue states that are mapped to the DevLake status 'DONE'. Defaults to 'closed'.",
+				Optional:    true,
+			},
+			"issue_status_in_progress": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Comma-separated bitbucket issue states that are mapped to the DevLake status 'IN_PROGRESS'. Empty by default.",
+				Optional:    true,
+			},
+			"issue_status_other": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("on hold,wontfix,duplicate,invalid"),
+				Description: "Comma-separated bitbucket issue states that are mapped to the DevLake status 'OTHER'. Defaults to 'on hold,wontfix,duplicate,invalid'.",
+				Optional:    true,
+			},
+			"issue_status_todo": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("new,open"),
+				Description: "Comma-separated bitbucket issue states that are mapped to the DevLake status 'TODO'. Defaults to 'new,open'.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the scope config.",
+				Required:    true,
+			},
+			"production_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(prod|release)"),
+				Description: "Convert a Bitbucket Pipelines run as a DevLake Deployment when: If the name also matches this pattern, this deployment is a 'Production Deployment'. Use only with 'deployment_pattern'. Defaults to '(prod|release)'.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *bitbucketConnectionScopeConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan bitbucketConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket cloud connection scopeconfig",
+			"Could not create devlake bitbucket cloud connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var bitbucketConnectionScopeConfigCreate = client.BitbucketConnectionScopeConfig{
+		ConnectionId: connectionId,
+		RefDiff: &client.RefDiff{
+			TagsLimit:   int(plan.RefDiff.TagsLimit.ValueInt64()),
+			TagsPattern: plan.RefDiff.TagsPattern.ValueString(),
+		},
+		DeploymentPattern:     plan.DeploymentPattern.ValueString(),
+		Entities:              entities,
+		IssueStatusDone:       plan.IssueStatusDone.ValueString(),
+		IssueStatusInProgress: plan.IssueStatusInProgress.ValueString(),
+		IssueStatusOther:      plan.IssueStatusOther.ValueString(),
+		IssueStatusTodo:       plan.IssueStatusTodo.ValueString(),
+		Name:                  plan.Name.ValueString(),
+		ProductionPattern:     plan.ProductionPattern.ValueString(),
+	}
+
+	// Create new bitbucket cloud connection scope config
+	bitbucketConnectionScopeConfig, err := r.client.CreateBitbucketConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), bitbucketConnectionScopeConfigCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket cloud connection scope config",
+			"Could not create devlake bitbucket cloud connection scope config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, bitbucketConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Entities = entitiesVal
+	plan.ID = types.StringValue(strconv.Itoa(bitbucketConnectionScopeConfig.ID))
+	plan.CreatedAt = types.StringValue(bitbucketConnectionScopeConfig.CreatedAt)
+	plan.DeploymentPattern = types.StringValue(bitbucketConnectionScopeConfig.DeploymentPattern)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.IssueStatusDone = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusDone)
+	plan.IssueStatusInProgress = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusInProgress)
+	plan.IssueStatusOther = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusOther)
+	plan.IssueStatusTodo = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusTodo)
+	plan.Name = types.StringValue(bitbucketConnectionScopeConfig.Name)
+	plan.ProductionPattern = types.StringValue(bitbucketConnectionScopeConfig.ProductionPattern)
+	plan.RefDiff.TagsLimit = types.Int64Value(int64(bitbucketConnectionScopeConfig.RefDiff.TagsLimit))
+	plan.RefDiff.TagsPattern = types.StringValue(bitbucketConnectionScopeConfig.RefDiff.TagsPattern)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bitbucketConnectionScopeConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state bitbucketConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed bitbucket cloud connection scope config value from Devlake
+	bitbucketConnectionScopeConfig, err := r.client.ReadBitbucketConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake bitbucket cloud connection scopeconfig",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite bitbucket cloud connection scope config with refreshed state
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, bitbucketConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CreatedAt = types.StringValue(bitbucketConnectionScopeConfig.CreatedAt)
+	state.DeploymentPattern = types.StringValue(bitbucketConnectionScopeConfig.DeploymentPattern)
+	state.Entities = entitiesVal
+	state.IssueStatusDone = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusDone)
+	state.IssueStatusInProgress = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusInProgress)
+	state.IssueStatusOther = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusOther)
+	state.IssueStatusTodo = types.StringValue(bitbucketConnectionScopeConfig.IssueStatusTodo)
+	state.Name = types.StringValue(bitbucketConnectionScopeConfig.Name)
+	state.ProductionPattern = types.StringValue(bitbucketConnectionScopeConfig.ProductionPattern)
+	if apiRefDiff := bitbucketConnectionScopeConfig.RefDiff; apiRefDiff != nil {
+		state.RefDiff = &refDiff{
+			TagsLimit:   types.Int64Value(int64(apiRefDiff.TagsLimit)),
+			TagsPattern: types.StringValue(apiRefDiff.TagsPattern),
+		}
+	} else {
+		state.RefDiff = nil
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *bitbucketConnectionScopeConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan bitbucketConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake bitbucket cloud connection scopeconfig",
+			"Could not update devlake bitbucket cloud connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake bitbucket cloud connection scopeconfig",
+			"Could not update devlake bitbucket cloud connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	var bitbucketConnectionScopeConfigUpdate = client.BitbucketConnectionScopeConfig{
+		ConnectionId:          connectionId,
+		ID:                    id,
+		DeploymentPattern:     plan.DeploymentPattern.ValueString(),
+		Entities:              entities,
+		IssueStatusDone:       plan.IssueStatusDone.ValueString(),
+		IssueStatusInProgress: plan.IssueStatusInProgress.ValueString(),
+		IssueStatusOther:      plan.IssueStatusOther.ValueString(),
+		IssueStatusTodo:       plan.IssueStatusTodo.ValueString(),
+		Name:                  plan.Name.ValueString(),
+		ProductionPattern:     plan.ProductionPattern.ValueString(),
+		RefDiff: &client.RefDiff{
+			TagsLimit:   int(plan.RefDiff.TagsLimit.ValueInt64()),
+			TagsPattern: plan.RefDiff.TagsPattern.ValueString(),
+		},
+	}
+
+	// Update existing connection scope config
+	updatedBitbucketConnectionScopeConfig, err := r.client.UpdateBitbucketConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), bitbucketConnectionScopeConfigUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake bitbucket cloud connection scopeconfig",
+			"Could not update devlake bitbucket cloud connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, updatedBitbucketConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.CreatedAt = types.StringValue(updatedBitbucketConnectionScopeConfig.CreatedAt)
+	plan.DeploymentPattern = types.StringValue(updatedBitbucketConnectionScopeConfig.DeploymentPattern)
+	plan.Entities = entitiesVal
+	plan.IssueStatusDone = types.StringValue(updatedBitbucketConnectionScopeConfig.IssueStatusDone)
+	plan.IssueStatusInProgress = types.StringValue(updatedBitbucketConnectionScopeConfig.IssueStatusInProgress)
+	plan.IssueStatusOther = types.StringValue(updatedBitbucketConnectionScopeConfig.IssueStatusOther)
+	plan.IssueStatusTodo = types.StringValue(updatedBitbucketConnectionScopeConfig.IssueStatusTodo)
+	plan.Name = types.StringValue(updatedBitbucketConnectionScopeConfig.Name)
+	plan.ProductionPattern = types.StringValue(updatedBitbucketConnectionScopeConfig.ProductionPattern)
+	plan.RefDiff.TagsLimit = types.Int64Value(int64(updatedBitbucketConnectionScopeConfig.RefDiff.TagsLimit))
+	plan.RefDiff.TagsPattern = types.StringValue(updatedBitbucketConnectionScopeConfig.RefDiff.TagsPattern)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bitbucketConnectionScopeConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state bitbucketConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope config
+	err := r.client.DeleteBitbucketConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake bitbucket cloud connection scopeconfig",
+			"Could not delete devlake bitbucket cloud connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *bitbucketConnectionScopeConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scopeconfig_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bitbucketConnectionScopeConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
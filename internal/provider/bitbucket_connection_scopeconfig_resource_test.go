@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	bitbucketConnectionScopeConfigConfig = bitbucketConnectionConfig + `
+resource "devlake_bitbucket_connection_scopeconfig" "scopeconf" {
+  connection_id	= devlake_bitbucket_connection.bbcloud.id
+  name          = "conf1"
+}
+`
+)
+
+func TestAccBitbucketConnectionScopeConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: bitbucketConnectionScopeConfigConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "name", "conf1"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.#", "5"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.0", "CODE"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.1", "TICKET"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.2", "CODEREVIEW"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.3", "CROSS"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.4", "CICD"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "deployment_pattern", "(deploy|push-image)"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "production_pattern", "(prod|release)"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_done", "closed"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_in_progress", ""),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_other", "on hold,wontfix,duplicate,invalid"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_todo", "new,open"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "ref_diff.tags_limit", "10"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "ref_diff.tags_pattern", `/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_bitbucket_connection_scopeconfig.scopeconf",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeConfigId string
+					if con, ok := s.RootModule().Resources["devlake_bitbucket_connection.bbcloud"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_bitbucket_connection.bbcloud not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_bitbucket_connection_scopeconfig.scopeconf"]; ok {
+						scopeConfigId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_bitbucket_connection_scopeconfig.scopeconf not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeConfigId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id"},
+			},
+			// Update and Read testing
+			{
+				Config: bitbucketConnectionConfig + `
+resource "devlake_bitbucket_connection_scopeconfig" "scopeconf" {
+  connection_id	= devlake_bitbucket_connection.bbcloud.id
+  name               = "conf2"
+  deployment_pattern = "deploy"
+  production_pattern = "prod"
+  issue_status_todo  = "new,open,submitted"
+  ref_diff = {
+    tags_limit = 11
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "name", "conf2"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.#", "5"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.0", "CODE"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.1", "TICKET"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.2", "CODEREVIEW"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.3", "CROSS"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "entities.4", "CICD"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "deployment_pattern", "deploy"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "production_pattern", "prod"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_done", "closed"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_in_progress", ""),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_other", "on hold,wontfix,duplicate,invalid"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "issue_status_todo", "new,open,submitted"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "ref_diff.tags_limit", "11"),
+					resource.TestCheckResourceAttr("devlake_bitbucket_connection_scopeconfig.scopeconf", "ref_diff.tags_pattern", `/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucket_connection_scopeconfig.scopeconf", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
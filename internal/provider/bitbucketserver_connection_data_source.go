@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bitbucketServerConnectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &bitbucketServerConnectionDataSource{}
+)
+
+// NewBitbucketServerConnectionDataSource is a helper function to simplify the provider implementation.
+func NewBitbucketServerConnectionDataSource() datasource.DataSource {
+	return &bitbucketServerConnectionDataSource{}
+}
+
+// bitbucketServerConnectionDataSource is the data source implementation.
+type bitbucketServerConnectionDataSource struct {
+	client *client.Client
+}
+
+// bitbucketServerConnectionDataSourceModel maps the data source schema data.
+// The password of the connection is omitted.
+type bitbucketServerConnectionDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	Name             types.String `tfsdk:"name"`
+	Proxy            types.String `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64  `tfsdk:"rate_limit_per_hour"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Username         types.String `tfsdk:"username"`
+}
+
+// Metadata returns the data source type name.
+func (d *bitbucketServerConnectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bitbucketserver_connection"
+}
+
+// Schema defines the schema for the data source.
+func (d *bitbucketServerConnectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := bitbucketServerConnectionDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Numeric identifier of the connection to look up. This is a string to match the connection_id of scopes. Exactly one of id and name must be set.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The name of the bitbucket server connection to look up. Exactly one of id and name must be set.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing bitbucket server connection by id or name, e.g. one created in the devlake config-ui. The password of the connection is not exposed.",
+		Attributes:  attributes,
+	}
+}
+
+// bitbucketServerConnectionDataSourceAttributes returns the computed
+// attributes of a bitbucket server connection, shared by the singular and the
+// plural data source.
+func bitbucketServerConnectionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Numeric identifier for the connection. This is a string to match the connection_id of scopes.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the connection was created in devlake.",
+		},
+		"endpoint": schema.StringAttribute{
+			Computed:    true,
+			Description: "The base endpoint URL.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the bitbucket server connection.",
+		},
+		"proxy": schema.StringAttribute{
+			Computed:    true,
+			Description: "The proxy server used to reach bitbucket server.",
+		},
+		"rate_limit_per_hour": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The rate limit devlake uses to collect Bitbucket Server/Data Center data.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the connection was updated in devlake.",
+		},
+		"username": schema.StringAttribute{
+			Computed:    true,
+			Description: "Service account username.",
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *bitbucketServerConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config bitbucketServerConnectionDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var bitbucketServerConnection *client.BitbucketServerConnection
+	if !config.ID.IsNull() {
+		connection, err := d.client.ReadBitbucketServerConnection(ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read devlake bitbucket server connection",
+				"Could not read devlake bitbucket server connection with id "+config.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		bitbucketServerConnection = connection
+	} else {
+		connections, err := d.client.ListBitbucketServerConnections(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read devlake bitbucket server connections",
+				err.Error(),
+			)
+			return
+		}
+		for _, connection := range connections {
+			if connection.Name == config.Name.ValueString() {
+				bitbucketServerConnection = &connection
+				break
+			}
+		}
+		if bitbucketServerConnection == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Devlake bitbucket server connection not found",
+				"There is no devlake bitbucket server connection named "+config.Name.ValueString()+".",
+			)
+			return
+		}
+	}
+
+	// Map response body to model
+	state := bitbucketServerConnectionToDataSourceModel(bitbucketServerConnection)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// bitbucketServerConnectionToDataSourceModel maps a bitbucket server
+// connection returned by devlake to the data source model.
+func bitbucketServerConnectionToDataSourceModel(connection *client.BitbucketServerConnection) bitbucketServerConnectionDataSourceModel {
+	return bitbucketServerConnectionDataSourceModel{
+		ID:               types.StringValue(strconv.Itoa(connection.ID)),
+		CreatedAt:        types.StringValue(connection.CreatedAt),
+		Endpoint:         types.StringValue(connection.Endpoint),
+		Name:             types.StringValue(connection.Name),
+		Proxy:            types.StringValue(connection.Proxy),
+		RateLimitPerHour: types.Int64Value(int64(connection.RateLimitPerHour)),
+		UpdatedAt:        types.StringValue(connection.UpdatedAt),
+		Username:         types.StringValue(connection.Username),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *bitbucketServerConnectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
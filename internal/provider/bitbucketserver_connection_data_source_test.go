@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	bitbucketServerConnectionDataSourceConfig = bitbucketServerConnectionConfig + `
+data "devlake_bitbucketserver_connection" "by_name" {
+  name = devlake_bitbucketserver_connection.bbserver.name
+}
+
+data "devlake_bitbucketserver_connection" "by_id" {
+  id = devlake_bitbucketserver_connection.bbserver.id
+}
+`
+)
+
+func TestAccBitbucketServerConnectionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: bitbucketServerConnectionDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.devlake_bitbucketserver_connection.by_name", "id", "devlake_bitbucketserver_connection.bbserver", "id"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection.by_name", "endpoint", "https://bitbucket-server.org"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection.by_name", "username", "serviceAccount"),
+					resource.TestCheckNoResourceAttr("data.devlake_bitbucketserver_connection.by_name", "password"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection.by_id", "name", "should_not_exist"),
+					resource.TestCheckResourceAttrSet("data.devlake_bitbucketserver_connection.by_id", "created_at"),
+				),
+			},
+		},
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"terraform-provider-devlake/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -37,16 +38,17 @@ type bitbucketServerConnectionResource struct {
 
 // bitbucketServerConnectionResourceModel maps the resource schema data.
 type bitbucketServerConnectionResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	LastUpdated      types.String `tfsdk:"last_updated"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	Endpoint         types.String `tfsdk:"endpoint"`
-	Name             types.String `tfsdk:"name"`
-	Password         types.String `tfsdk:"password"`
-	Proxy            types.String `tfsdk:"proxy"`
-	RateLimitPerHour types.Int64  `tfsdk:"rate_limit_per_hour"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
-	Username         types.String `tfsdk:"username"`
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Endpoint         types.String   `tfsdk:"endpoint"`
+	Name             types.String   `tfsdk:"name"`
+	Password         types.String   `tfsdk:"password"`
+	Proxy            types.String   `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64    `tfsdk:"rate_limit_per_hour"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Username         types.String   `tfsdk:"username"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -55,7 +57,7 @@ func (r *bitbucketServerConnectionResource) Metadata(_ context.Context, req reso
 }
 
 // Schema defines the schema for the resource.
-func (r *bitbucketServerConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *bitbucketServerConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -105,6 +107,14 @@ func (r *bitbucketServerConnectionResource) Schema(_ context.Context, _ resource
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -118,6 +128,14 @@ func (r *bitbucketServerConnectionResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	now := time.Now().Format(time.RFC850)
 
 	// Generate API request body from plan
@@ -133,7 +151,7 @@ func (r *bitbucketServerConnectionResource) Create(ctx context.Context, req reso
 	}
 
 	// Create new bitbucketserverconnection
-	bitbucketServerConnection, err := r.client.CreateBitbucketServerConnection(bitbucketServerConnectionCreate)
+	bitbucketServerConnection, err := r.client.CreateBitbucketServerConnection(ctx, bitbucketServerConnectionCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake bitbucket server connection",
@@ -171,9 +189,23 @@ func (r *bitbucketServerConnectionResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed bitbucket server connection value from Devlake
-	bitbucketServerConnection, err := r.client.ReadBitbucketServerConnection(state.ID.ValueString())
+	bitbucketServerConnection, err := r.client.ReadBitbucketServerConnection(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read devlake bitbucket server connection",
 			err.Error(),
@@ -209,6 +241,14 @@ func (r *bitbucketServerConnectionResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	id, err := strconv.Atoi(plan.ID.ValueString())
 	if err != nil {
@@ -231,7 +271,7 @@ func (r *bitbucketServerConnectionResource) Update(ctx context.Context, req reso
 	}
 
 	// Update existing connection
-	updatedBitbucketServerConnection, err := r.client.UpdateBitbucketServerConnection(plan.ID.ValueString(), bitbucketServerConnectionUpdate)
+	updatedBitbucketServerConnection, err := r.client.UpdateBitbucketServerConnection(ctx, plan.ID.ValueString(), bitbucketServerConnectionUpdate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating devlake bitbucket server connection",
@@ -267,8 +307,16 @@ func (r *bitbucketServerConnectionResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing apikey
-	err := r.client.DeleteBitbucketServerConnection(state.ID.ValueString())
+	err := r.client.DeleteBitbucketServerConnection(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting devlake bitbucket server connection",
@@ -11,6 +11,7 @@ import (
 
 	"terraform-provider-devlake/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,15 +39,16 @@ type bitbucketServerConnectionScopeResource struct {
 
 // bitbucketServerConnectionScopeResourceModel maps the resource schema data.
 type bitbucketServerConnectionScopeResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	LastUpdated   types.String `tfsdk:"last_updated"`
-	CloneUrl      types.String `tfsdk:"clone_url"`
-	ConnectionId  types.String `tfsdk:"connection_id"`
-	CreatedAt     types.String `tfsdk:"created_at"`
-	Description   types.String `tfsdk:"description"`
-	HTMLUrl       types.String `tfsdk:"html_url"`
-	Name          types.String `tfsdk:"name"`
-	ScopeConfigId types.String `tfsdk:"scope_config_id"`
+	ID            types.String   `tfsdk:"id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	CloneUrl      types.String   `tfsdk:"clone_url"`
+	ConnectionId  types.String   `tfsdk:"connection_id"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Description   types.String   `tfsdk:"description"`
+	HTMLUrl       types.String   `tfsdk:"html_url"`
+	Name          types.String   `tfsdk:"name"`
+	ScopeConfigId types.String   `tfsdk:"scope_config_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -55,7 +57,7 @@ func (r *bitbucketServerConnectionScopeResource) Metadata(_ context.Context, req
 }
 
 // Schema defines the schema for the resource.
-func (r *bitbucketServerConnectionScopeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *bitbucketServerConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -104,6 +106,14 @@ func (r *bitbucketServerConnectionScopeResource) Schema(_ context.Context, _ res
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -117,6 +127,14 @@ func (r *bitbucketServerConnectionScopeResource) Create(ctx context.Context, req
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
 	if err != nil {
@@ -148,7 +166,7 @@ func (r *bitbucketServerConnectionScopeResource) Create(ctx context.Context, req
 	}
 
 	// Create new bitbucketserverconnectionscope
-	bitbucketServerConnectionScope, err := r.client.CreateBitbucketServerConnectionScope(plan.ConnectionId.ValueString(), bitbucketServerConnectionScopeCreate)
+	bitbucketServerConnectionScope, err := r.client.CreateBitbucketServerConnectionScope(ctx, plan.ConnectionId.ValueString(), bitbucketServerConnectionScopeCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake bitbucket server connection scope",
@@ -186,9 +204,23 @@ func (r *bitbucketServerConnectionScopeResource) Read(ctx context.Context, req r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed bitbucket server connection scope value from Devlake
-	bitbucketServerConnectionScope, err := r.client.ReadBitbucketServerConnectionScope(state.ConnectionId.ValueString(), state.ID.ValueString())
+	bitbucketServerConnectionScope, err := r.client.ReadBitbucketServerConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read devlake bitbucket server connection scope",
 			err.Error(),
@@ -224,6 +256,14 @@ func (r *bitbucketServerConnectionScopeResource) Update(ctx context.Context, req
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
 	if err != nil {
@@ -254,7 +294,7 @@ func (r *bitbucketServerConnectionScopeResource) Update(ctx context.Context, req
 	}
 
 	// Update existing connection scope
-	updatedBitbucketServerConnectionScope, err := r.client.UpdateBitbucketServerConnectionScope(plan.ConnectionId.ValueString(), plan.ID.ValueString(), bitbucketServerConnectionScopeUpdate)
+	updatedBitbucketServerConnectionScope, err := r.client.UpdateBitbucketServerConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), bitbucketServerConnectionScopeUpdate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating devlake bitbucket server connection scope",
@@ -288,8 +328,16 @@ func (r *bitbucketServerConnectionScopeResource) Delete(ctx context.Context, req
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing apikey
-	err := r.client.DeleteBitbucketServerConnectionScope(state.ConnectionId.ValueString(), state.ID.ValueString())
+	err := r.client.DeleteBitbucketServerConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting devlake bitbucket server connection scope",
@@ -11,7 +11,9 @@ import (
 
 	"terraform-provider-devlake/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -43,8 +45,15 @@ type bitbucketServerConnectionScopeConfigResource struct {
 
 // bitbucketServerConnectionScopeConfigResourceModel maps the resource schema data.
 type bitbucketServerConnectionScopeConfigResourceModel struct {
+	bitbucketServerConnectionScopeConfigModel
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// bitbucketServerConnectionScopeConfigModel maps the scope config attributes
+// of devlake, shared with the scope config of the scopes data source.
+type bitbucketServerConnectionScopeConfigModel struct {
 	ID           types.String `tfsdk:"id"`
-	LastUpdated  types.String `tfsdk:"last_updated"`
 	ConnectionId types.String `tfsdk:"connection_id"`
 	CreatedAt    types.String `tfsdk:"created_at"`
 	Entities     types.List   `tfsdk:"entities"`
@@ -59,13 +68,24 @@ type refDiff struct {
 	TagsPattern types.String `tfsdk:"tags_pattern"`
 }
 
+// refDiffToModel maps the optional ref diff of devlake to the model.
+func refDiffToModel(apiRefDiff *client.RefDiff) *refDiff {
+	if apiRefDiff == nil {
+		return nil
+	}
+	return &refDiff{
+		TagsLimit:   types.Int64Value(int64(apiRefDiff.TagsLimit)),
+		TagsPattern: types.StringValue(apiRefDiff.TagsPattern),
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *bitbucketServerConnectionScopeConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_bitbucketserver_connection_scopeconfig"
 }
 
 // Schema defines the schema for the resource.
-func (r *bitbucketServerConnectionScopeConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *bitbucketServerConnectionScopeConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -151,6 +171,14 @@ func (r *bitbucketServerConnectionScopeConfigResource) Schema(_ context.Context,
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -164,6 +192,14 @@ func (r *bitbucketServerConnectionScopeConfigResource) Create(ctx context.Contex
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var entities []string
 	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
@@ -194,7 +230,7 @@ func (r *bitbucketServerConnectionScopeConfigResource) Create(ctx context.Contex
 	}
 
 	// Create new bitbucketserverconnectionscopeconfig
-	bitbucketServerConnectionScopeConfig, err := r.client.CreateBitbucketServerConnectionScopeConfig(plan.ConnectionId.ValueString(), bitbucketServerConnectionScopeConfigCreate)
+	bitbucketServerConnectionScopeConfig, err := r.client.CreateBitbucketServerConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), bitbucketServerConnectionScopeConfigCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake bitbucket server connection scope config",
@@ -204,20 +240,11 @@ func (r *bitbucketServerConnectionScopeConfigResource) Create(ctx context.Contex
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, bitbucketServerConnectionScopeConfig.Entities)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(bitbucketServerConnectionScopeConfigToModel(ctx, bitbucketServerConnectionScopeConfig, &plan.bitbucketServerConnectionScopeConfigModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.Entities = entitiesVal
-	plan.ID = types.StringValue(strconv.Itoa(bitbucketServerConnectionScopeConfig.ID))
-	plan.CreatedAt = types.StringValue(bitbucketServerConnectionScopeConfig.CreatedAt)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-	plan.Name = types.StringValue(bitbucketServerConnectionScopeConfig.Name)
-	plan.PrComponent = types.StringValue(bitbucketServerConnectionScopeConfig.PrComponent)
-	plan.PrType = types.StringValue(bitbucketServerConnectionScopeConfig.PrType)
-	plan.RefDiff.TagsLimit = types.Int64Value(int64(bitbucketServerConnectionScopeConfig.RefDiff.TagsLimit))
-	plan.RefDiff.TagsPattern = types.StringValue(bitbucketServerConnectionScopeConfig.RefDiff.TagsPattern)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -237,9 +264,23 @@ func (r *bitbucketServerConnectionScopeConfigResource) Read(ctx context.Context,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed bitbucket server connection scope config value from Devlake
-	bitbucketServerConnectionScopeConfig, err := r.client.ReadBitbucketServerConnectionScopeConfig(state.ConnectionId.ValueString(), state.ID.ValueString())
+	bitbucketServerConnectionScopeConfig, err := r.client.ReadBitbucketServerConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read devlake bitbucket server connection scopeconfig",
 			err.Error(),
@@ -248,24 +289,10 @@ func (r *bitbucketServerConnectionScopeConfigResource) Read(ctx context.Context,
 	}
 
 	// Overwrite bitbucket server connection scope config with refreshed state
-	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, bitbucketServerConnectionScopeConfig.Entities)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(bitbucketServerConnectionScopeConfigToModel(ctx, bitbucketServerConnectionScopeConfig, &state.bitbucketServerConnectionScopeConfigModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.CreatedAt = types.StringValue(bitbucketServerConnectionScopeConfig.CreatedAt)
-	state.Entities = entitiesVal
-	state.Name = types.StringValue(bitbucketServerConnectionScopeConfig.Name)
-	state.PrComponent = types.StringValue(bitbucketServerConnectionScopeConfig.PrComponent)
-	state.PrType = types.StringValue(bitbucketServerConnectionScopeConfig.PrType)
-	if apiRefDiff := bitbucketServerConnectionScopeConfig.RefDiff; apiRefDiff != nil {
-		state.RefDiff = &refDiff{
-			TagsLimit:   types.Int64Value(int64(apiRefDiff.TagsLimit)),
-			TagsPattern: types.StringValue(apiRefDiff.TagsPattern),
-		}
-	} else {
-		state.RefDiff = nil
-	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -285,6 +312,14 @@ func (r *bitbucketServerConnectionScopeConfigResource) Update(ctx context.Contex
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
 	if err != nil {
@@ -324,7 +359,7 @@ func (r *bitbucketServerConnectionScopeConfigResource) Update(ctx context.Contex
 	}
 
 	// Update existing connection scope config
-	updatedBitbucketServerConnectionScopeConfig, err := r.client.UpdateBitbucketServerConnectionScopeConfig(plan.ConnectionId.ValueString(), plan.ID.ValueString(), bitbucketServerConnectionScopeConfigUpdate)
+	updatedBitbucketServerConnectionScopeConfig, err := r.client.UpdateBitbucketServerConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), bitbucketServerConnectionScopeConfigUpdate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating devlake bitbucket server connection scopeconfig",
@@ -333,18 +368,10 @@ func (r *bitbucketServerConnectionScopeConfigResource) Update(ctx context.Contex
 		return
 	}
 
-	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, updatedBitbucketServerConnectionScopeConfig.Entities)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(bitbucketServerConnectionScopeConfigToModel(ctx, updatedBitbucketServerConnectionScopeConfig, &plan.bitbucketServerConnectionScopeConfigModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.CreatedAt = types.StringValue(updatedBitbucketServerConnectionScopeConfig.CreatedAt)
-	plan.Entities = entitiesVal
-	plan.Name = types.StringValue(updatedBitbucketServerConnectionScopeConfig.Name)
-	plan.PrComponent = types.StringValue(updatedBitbucketServerConnectionScopeConfig.PrComponent)
-	plan.PrType = types.StringValue(updatedBitbucketServerConnectionScopeConfig.PrType)
-	plan.RefDiff.TagsLimit = types.Int64Value(int64(updatedBitbucketServerConnectionScopeConfig.RefDiff.TagsLimit))
-	plan.RefDiff.TagsPattern = types.StringValue(updatedBitbucketServerConnectionScopeConfig.RefDiff.TagsPattern)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -363,8 +390,16 @@ func (r *bitbucketServerConnectionScopeConfigResource) Delete(ctx context.Contex
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing connection scope config
-	err := r.client.DeleteBitbucketServerConnectionScopeConfig(state.ConnectionId.ValueString(), state.ID.ValueString())
+	err := r.client.DeleteBitbucketServerConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting devlake bitbucket server connection scopeconfig",
@@ -410,3 +445,22 @@ func (r *bitbucketServerConnectionScopeConfigResource) Configure(_ context.Conte
 
 	r.client = client
 }
+
+// bitbucketServerConnectionScopeConfigToModel maps a bitbucket server scope
+// config of devlake to the model, it is used by the resource and the scopes
+// data source.
+func bitbucketServerConnectionScopeConfigToModel(ctx context.Context, scopeConfig *client.BitbucketServerConnectionScopeConfig, model *bitbucketServerConnectionScopeConfigModel) diag.Diagnostics {
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, scopeConfig.Entities)
+	if diags.HasError() {
+		return diags
+	}
+	model.ID = types.StringValue(strconv.Itoa(scopeConfig.ID))
+	model.ConnectionId = types.StringValue(strconv.Itoa(scopeConfig.ConnectionId))
+	model.CreatedAt = types.StringValue(scopeConfig.CreatedAt)
+	model.Entities = entitiesVal
+	model.Name = types.StringValue(scopeConfig.Name)
+	model.PrComponent = types.StringValue(scopeConfig.PrComponent)
+	model.PrType = types.StringValue(scopeConfig.PrType)
+	model.RefDiff = refDiffToModel(scopeConfig.RefDiff)
+	return diags
+}
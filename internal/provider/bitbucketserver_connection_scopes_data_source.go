@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bitbucketServerConnectionScopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &bitbucketServerConnectionScopesDataSource{}
+)
+
+// NewBitbucketServerConnectionScopesDataSource is a helper function to simplify the provider implementation.
+func NewBitbucketServerConnectionScopesDataSource() datasource.DataSource {
+	return &bitbucketServerConnectionScopesDataSource{}
+}
+
+// bitbucketServerConnectionScopesDataSource is the data source implementation.
+type bitbucketServerConnectionScopesDataSource struct {
+	client *client.Client
+}
+
+// bitbucketServerConnectionScopesDataSourceModel maps the data source schema data.
+type bitbucketServerConnectionScopesDataSourceModel struct {
+	ConnectionId types.String                                    `tfsdk:"connection_id"`
+	Scopes       []bitbucketServerConnectionScopeDataSourceModel `tfsdk:"scopes"`
+	SearchTerm   types.String                                    `tfsdk:"search_term"`
+}
+
+// bitbucketServerConnectionScopeDataSourceModel maps the scope schema data.
+type bitbucketServerConnectionScopeDataSourceModel struct {
+	ID            types.String                               `tfsdk:"id"`
+	Blueprints    []scopeBlueprintDataSourceModel            `tfsdk:"blueprints"`
+	CloneUrl      types.String                               `tfsdk:"clone_url"`
+	CreatedAt     types.String                               `tfsdk:"created_at"`
+	Description   types.String                               `tfsdk:"description"`
+	HtmlUrl       types.String                               `tfsdk:"html_url"`
+	Name          types.String                               `tfsdk:"name"`
+	ScopeConfig   *bitbucketServerConnectionScopeConfigModel `tfsdk:"scope_config"`
+	ScopeConfigId types.String                               `tfsdk:"scope_config_id"`
+	UpdatedAt     types.String                               `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *bitbucketServerConnectionScopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bitbucketserver_connection_scopes"
+}
+
+// Schema defines the schema for the data source.
+func (d *bitbucketServerConnectionScopesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	scopeConfigAttribute, diags := scopeConfigDataSourceAttribute(ctx, NewBitbucketServerConnectionScopeConfigResource())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the scopes of a bitbucket server connection with their scope config and the blueprints referencing them, e.g. to audit the scopes in terraform checks.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Description: "The bitbucket server connection to list the scopes of.",
+				Required:    true,
+			},
+			"scopes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The scopes of the connection.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Bitbucket project and repository in the format '<PROJECT>/repos/<REPOSITORY>'.",
+						},
+						"blueprints": scopeBlueprintsDataSourceAttribute(),
+						"clone_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Bitbucket https clone url.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the scope was created in devlake.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the scope.",
+						},
+						"html_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Bitbucket HTML browse url.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the repository.",
+						},
+						"scope_config": scopeConfigAttribute,
+						"scope_config_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the scope config attached to the scope, null if there is none.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the scope was updated in devlake.",
+						},
+					},
+				},
+			},
+			"search_term": schema.StringAttribute{
+				Description: "Only list the scopes whose name contains the search term.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *bitbucketServerConnectionScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state bitbucketServerConnectionScopesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bitbucketServerConnectionScopes, err := d.client.ListBitbucketServerConnectionScopeDetails(ctx, state.ConnectionId.ValueString(), state.SearchTerm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake bitbucket server connection scopes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Scopes = []bitbucketServerConnectionScopeDataSourceModel{}
+	for _, bitbucketServerConnectionScope := range bitbucketServerConnectionScopes {
+		scope := bitbucketServerConnectionScope.Scope
+		scopeState := bitbucketServerConnectionScopeDataSourceModel{
+			ID:            types.StringValue(scope.BitbucketId),
+			Blueprints:    scopeBlueprintsToDataSourceModel(bitbucketServerConnectionScope.Blueprints),
+			CloneUrl:      types.StringValue(scope.CloneUrl),
+			CreatedAt:     types.StringValue(scope.CreatedAt),
+			Description:   types.StringValue(scope.Description),
+			HtmlUrl:       types.StringValue(scope.HTMLUrl),
+			Name:          types.StringValue(scope.Name),
+			ScopeConfigId: scopeConfigIdToDataSourceModel(scope.ScopeConfigId),
+			UpdatedAt:     types.StringValue(scope.UpdatedAt),
+		}
+		if scopeConfig := bitbucketServerConnectionScope.ScopeConfig; scopeConfig != nil && scopeConfig.ID != 0 {
+			scopeState.ScopeConfig = &bitbucketServerConnectionScopeConfigModel{}
+			resp.Diagnostics.Append(bitbucketServerConnectionScopeConfigToModel(ctx, scopeConfig, scopeState.ScopeConfig)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		state.Scopes = append(state.Scopes, scopeState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *bitbucketServerConnectionScopesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBitbucketServerConnectionScopesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the scope first, data sources are read before
+			// dependent resources are created otherwise.
+			{
+				Config: bitbucketServerConnectionScopeConfig,
+			},
+			// Read testing
+			{
+				Config: bitbucketServerConnectionScopeConfig + `
+data "devlake_bitbucketserver_connection_scopes" "test" {
+  connection_id = devlake_bitbucketserver_connection.bbserver.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection_scopes.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection_scopes.test", "scopes.0.id", "PROJECT/repos/REPO"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection_scopes.test", "scopes.0.name", "PROJECT/REPO"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection_scopes.test", "scopes.0.blueprints.#", "0"),
+					resource.TestCheckResourceAttrPair("data.devlake_bitbucketserver_connection_scopes.test", "scopes.0.scope_config.id", "devlake_bitbucketserver_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connection_scopes.test", "scopes.0.scope_config.name", "conf1"),
+					resource.TestCheckResourceAttrPair("data.devlake_bitbucketserver_connection_scopes.test", "scopes.0.scope_config.pr_type", "devlake_bitbucketserver_connection_scopeconfig.scopeconf", "pr_type"),
+					resource.TestCheckResourceAttrPair("data.devlake_bitbucketserver_connection_scopes.test", "scopes.0.scope_config.ref_diff.tags_limit", "devlake_bitbucketserver_connection_scopeconfig.scopeconf", "ref_diff.tags_limit"),
+				),
+			},
+		},
+	})
+}
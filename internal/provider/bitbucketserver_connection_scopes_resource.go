@@ -0,0 +1,420 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bitbucketServerConnectionScopesResource{}
+	_ resource.ResourceWithConfigure   = &bitbucketServerConnectionScopesResource{}
+	_ resource.ResourceWithImportState = &bitbucketServerConnectionScopesResource{}
+)
+
+// NewBitbucketServerConnectionScopesResource is a helper function to simplify the provider implementation.
+func NewBitbucketServerConnectionScopesResource() resource.Resource {
+	return &bitbucketServerConnectionScopesResource{}
+}
+
+// bitbucketServerConnectionScopesResource is the resource implementation.
+type bitbucketServerConnectionScopesResource struct {
+	client *client.Client
+}
+
+// bitbucketServerConnectionScopesResourceModel maps the resource schema data.
+type bitbucketServerConnectionScopesResourceModel struct {
+	ID            types.String                                     `tfsdk:"id"`
+	LastUpdated   types.String                                     `tfsdk:"last_updated"`
+	ConnectionId  types.String                                     `tfsdk:"connection_id"`
+	Repositories  []bitbucketServerConnectionScopesRepositoryModel `tfsdk:"repositories"`
+	ScopeConfigId types.String                                     `tfsdk:"scope_config_id"`
+	Timeouts      timeouts.Value                                   `tfsdk:"timeouts"`
+}
+
+// bitbucketServerConnectionScopesRepositoryModel maps the repository schema data.
+type bitbucketServerConnectionScopesRepositoryModel struct {
+	ID          types.String `tfsdk:"id"`
+	CloneUrl    types.String `tfsdk:"clone_url"`
+	Description types.String `tfsdk:"description"`
+	HTMLUrl     types.String `tfsdk:"html_url"`
+	Name        types.String `tfsdk:"name"`
+}
+
+// Metadata returns the resource type name.
+func (r *bitbucketServerConnectionScopesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bitbucketserver_connection_scopes"
+}
+
+// Schema defines the schema for the resource.
+func (r *bitbucketServerConnectionScopesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages many repositories of a bitbucket server connection with a shared scope config, they are created in batches. Scopes of the connection which are not part of the repositories are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the connection, used for resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scopes.",
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection the scopes are part of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"repositories": schema.SetNestedAttribute{
+				Description: "The repositories to add as scopes.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The Bitbucket project and repository in the format '<PROJECT>/repos/<REPOSITORY>'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]+/repos/[^/]+$`), "must be in the format '<PROJECT>/repos/<REPOSITORY>'"),
+							},
+						},
+						"clone_url": schema.StringAttribute{
+							Description: "The Bitbucket https clone url.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description for the connection scope.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"html_url": schema.StringAttribute{
+							Description: "The Bitbucket HTML browse url.",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "A name for the connection scope.",
+							Required:    true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for all scopes. Needs to be created first.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *bitbucketServerConnectionScopesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan bitbucketServerConnectionScopesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	bitbucketServerConnectionScopesCreate, err := r.scopesFromModel(plan, plan.Repositories)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket server connection scopes",
+			"Could not create devlake bitbucket server connection scopes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Create new bitbucket server connection scopes
+	_, err = r.client.CreateBitbucketServerConnectionScopes(ctx, plan.ConnectionId.ValueString(), bitbucketServerConnectionScopesCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake bitbucket server connection scopes",
+			"Could not create devlake bitbucket server connection scopes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values
+	plan.ID = plan.ConnectionId
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bitbucketServerConnectionScopesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state bitbucketServerConnectionScopesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed bitbucket server connection scopes from Devlake
+	bitbucketServerConnectionScopes, err := r.client.ListBitbucketServerConnectionScopes(ctx, state.ConnectionId.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake bitbucket server connection scopes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite the managed repositories with refreshed state, all scopes of
+	// the connection are managed after an import.
+	imported := state.Repositories == nil
+	managed := map[string]bool{}
+	for _, repository := range state.Repositories {
+		managed[repository.ID.ValueString()] = true
+	}
+	state.Repositories = []bitbucketServerConnectionScopesRepositoryModel{}
+	scopeConfigId := state.ScopeConfigId
+	for _, bitbucketServerConnectionScope := range bitbucketServerConnectionScopes {
+		id := bitbucketServerConnectionScope.BitbucketId
+		if !imported && !managed[id] {
+			continue
+		}
+		state.Repositories = append(state.Repositories, bitbucketServerConnectionScopesRepositoryModel{
+			ID:          types.StringValue(id),
+			CloneUrl:    types.StringValue(bitbucketServerConnectionScope.CloneUrl),
+			Description: optionalStringToModel(bitbucketServerConnectionScope.Description),
+			HTMLUrl:     types.StringValue(bitbucketServerConnectionScope.HTMLUrl),
+			Name:        types.StringValue(bitbucketServerConnectionScope.Name),
+		})
+		// a scope with another scope config shows up as change
+		if id := strconv.Itoa(bitbucketServerConnectionScope.ScopeConfigId); id != state.ScopeConfigId.ValueString() {
+			scopeConfigId = types.StringValue(id)
+		}
+	}
+	state.ID = state.ConnectionId
+	state.ScopeConfigId = scopeConfigId
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update adds, updates and removes scopes according to the difference of
+// the planned and the current repositories.
+func (r *bitbucketServerConnectionScopesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state bitbucketServerConnectionScopesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Changed and added repositories are created again, which updates them.
+	current := map[string]bitbucketServerConnectionScopesRepositoryModel{}
+	for _, repository := range state.Repositories {
+		current[repository.ID.ValueString()] = repository
+	}
+	scopeConfigChanged := !plan.ScopeConfigId.Equal(state.ScopeConfigId)
+	changed := []bitbucketServerConnectionScopesRepositoryModel{}
+	for _, repository := range plan.Repositories {
+		if currentRepository, ok := current[repository.ID.ValueString()]; !ok || scopeConfigChanged || currentRepository != repository {
+			changed = append(changed, repository)
+		}
+		delete(current, repository.ID.ValueString())
+	}
+
+	if len(changed) > 0 {
+		bitbucketServerConnectionScopesUpdate, err := r.scopesFromModel(plan, changed)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating devlake bitbucket server connection scopes",
+				"Could not update devlake bitbucket server connection scopes, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		_, err = r.client.CreateBitbucketServerConnectionScopes(ctx, plan.ConnectionId.ValueString(), bitbucketServerConnectionScopesUpdate)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating devlake bitbucket server connection scopes",
+				"Could not update devlake bitbucket server connection scopes, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// The remaining current repositories were removed from the plan.
+	for id := range current {
+		err := r.client.DeleteBitbucketServerConnectionScope(ctx, plan.ConnectionId.ValueString(), id)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error updating devlake bitbucket server connection scopes",
+				"Could not delete devlake bitbucket server connection scope "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = plan.ConnectionId
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bitbucketServerConnectionScopesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state bitbucketServerConnectionScopesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing scopes
+	for _, repository := range state.Repositories {
+		err := r.client.DeleteBitbucketServerConnectionScope(ctx, state.ConnectionId.ValueString(), repository.ID.ValueString())
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting devlake bitbucket server connection scopes",
+				"Could not delete devlake bitbucket server connection scope "+repository.ID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *bitbucketServerConnectionScopesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and connection id attribute, all
+	// scopes of the connection are imported.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// scopesFromModel generates the API request body for the given repositories.
+func (r *bitbucketServerConnectionScopesResource) scopesFromModel(plan bitbucketServerConnectionScopesResourceModel, repositories []bitbucketServerConnectionScopesRepositoryModel) ([]client.BitbucketServerConnectionScope, error) {
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	scopes := []client.BitbucketServerConnectionScope{}
+	for _, repository := range repositories {
+		scopes = append(scopes, client.BitbucketServerConnectionScope{
+			BitbucketId:   repository.ID.ValueString(),
+			CloneUrl:      repository.CloneUrl.ValueString(),
+			ConnectionId:  connectionId,
+			CreatedAt:     now,
+			Description:   repository.Description.ValueString(),
+			HTMLUrl:       repository.HTMLUrl.ValueString(),
+			Name:          repository.Name.ValueString(),
+			ScopeConfigId: scopeConfigId,
+			UpdatedAt:     now,
+		})
+	}
+	return scopes, nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bitbucketServerConnectionScopesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
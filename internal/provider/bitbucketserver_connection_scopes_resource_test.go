@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	bitbucketServerConnectionScopesConfig = bitbucketServerConnectionScopeConfigConfig + `
+resource "devlake_bitbucketserver_connection_scopes" "scopes" {
+  connection_id	= devlake_bitbucketserver_connection.bbserver.id
+  repositories = [
+    {
+      id = "PROJECT/repos/REPO"
+      clone_url = "https://bitbucket-server.org/scp/project/repos/repo.git"
+      description = "example repo"
+      html_url = "https://bitbucket-server.org/projects/PROJECT/repos/REPO/browse"
+      name = "PROJECT/REPO"
+    },
+    {
+      id = "PROJECT/repos/REPO2"
+      clone_url = "https://bitbucket-server.org/scp/project/repos/repo2.git"
+      html_url = "https://bitbucket-server.org/projects/PROJECT/repos/REPO2/browse"
+      name = "PROJECT/REPO2"
+    },
+  ]
+  scope_config_id = devlake_bitbucketserver_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccBitbucketServerConnectionScopesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: bitbucketServerConnectionScopesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucketserver_connection_scopes.scopes", "repositories.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_bitbucketserver_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":          "PROJECT/repos/REPO",
+						"description": "example repo",
+						"name":        "PROJECT/REPO",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_bitbucketserver_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":   "PROJECT/repos/REPO2",
+						"name": "PROJECT/REPO2",
+					}),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucketserver_connection_scopes.scopes", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucketserver_connection_scopes.scopes", "id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucketserver_connection_scopes.scopes", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucketserver_connection_scopes.scopes", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devlake_bitbucketserver_connection_scopes.scopes",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: bitbucketServerConnectionScopeConfigConfig + `
+resource "devlake_bitbucketserver_connection_scopes" "scopes" {
+  connection_id	= devlake_bitbucketserver_connection.bbserver.id
+  repositories = [
+    {
+      id = "PROJECT/repos/REPO"
+      clone_url = "https://bitbucket-server.org/scp/project/repos/repo.git"
+      description = "example repo desc"
+      html_url = "https://bitbucket-server.org/projects/PROJECT/repos/REPO/browse"
+      name = "PROJECT/REPO"
+    },
+    {
+      id = "PROJECT/repos/REPO3"
+      clone_url = "https://bitbucket-server.org/scp/project/repos/repo3.git"
+      html_url = "https://bitbucket-server.org/projects/PROJECT/repos/REPO3/browse"
+      name = "PROJECT/REPO3"
+    },
+  ]
+  scope_config_id = devlake_bitbucketserver_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_bitbucketserver_connection_scopes.scopes", "repositories.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_bitbucketserver_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":          "PROJECT/repos/REPO",
+						"description": "example repo desc",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_bitbucketserver_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":   "PROJECT/repos/REPO3",
+						"name": "PROJECT/REPO3",
+					}),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_bitbucketserver_connection_scopes.scopes", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucketserver_connection_scopes.scopes", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_bitbucketserver_connection_scopes.scopes", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bitbucketServerConnectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &bitbucketServerConnectionsDataSource{}
+)
+
+// NewBitbucketServerConnectionsDataSource is a helper function to simplify the provider implementation.
+func NewBitbucketServerConnectionsDataSource() datasource.DataSource {
+	return &bitbucketServerConnectionsDataSource{}
+}
+
+// bitbucketServerConnectionsDataSource is the data source implementation.
+type bitbucketServerConnectionsDataSource struct {
+	client *client.Client
+}
+
+// bitbucketServerConnectionsDataSourceModel maps the data source schema data.
+type bitbucketServerConnectionsDataSourceModel struct {
+	Connections []bitbucketServerConnectionDataSourceModel `tfsdk:"connections"`
+}
+
+// Metadata returns the data source type name.
+func (d *bitbucketServerConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bitbucketserver_connections"
+}
+
+// Schema defines the schema for the data source.
+func (d *bitbucketServerConnectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all bitbucket server connections. The passwords of the connections are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"connections": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: bitbucketServerConnectionDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *bitbucketServerConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := bitbucketServerConnectionsDataSourceModel{
+		Connections: []bitbucketServerConnectionDataSourceModel{},
+	}
+
+	bitbucketServerConnections, err := d.client.ListBitbucketServerConnections(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake bitbucket server connections",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	for _, bitbucketServerConnection := range bitbucketServerConnections {
+		connectionState := bitbucketServerConnectionToDataSourceModel(&bitbucketServerConnection)
+
+		state.Connections = append(state.Connections, connectionState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *bitbucketServerConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBitbucketServerConnectionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the connection first, data sources are read before
+			// dependent resources are created otherwise.
+			{
+				Config: bitbucketServerConnectionConfig,
+			},
+			// Read testing
+			{
+				Config: bitbucketServerConnectionConfig + `data "devlake_bitbucketserver_connections" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connections.test", "connections.#", "1"),
+					resource.TestCheckResourceAttrPair("data.devlake_bitbucketserver_connections.test", "connections.0.id", "devlake_bitbucketserver_connection.bbserver", "id"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connections.test", "connections.0.name", "should_not_exist"),
+					resource.TestCheckResourceAttr("data.devlake_bitbucketserver_connections.test", "connections.0.username", "serviceAccount"),
+					resource.TestCheckNoResourceAttr("data.devlake_bitbucketserver_connections.test", "connections.0.password"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,588 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &blueprintResource{}
+	_ resource.ResourceWithConfigure      = &blueprintResource{}
+	_ resource.ResourceWithImportState    = &blueprintResource{}
+	_ resource.ResourceWithValidateConfig = &blueprintResource{}
+)
+
+// NewBlueprintResource is a helper function to simplify the provider implementation.
+func NewBlueprintResource() resource.Resource {
+	return &blueprintResource{}
+}
+
+// blueprintResource is the resource implementation.
+type blueprintResource struct {
+	client *client.Client
+}
+
+// blueprintResourceModel maps the resource schema data.
+type blueprintResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	LastUpdated types.String               `tfsdk:"last_updated"`
+	Connections []blueprintConnectionModel `tfsdk:"connections"`
+	CreatedAt   types.String               `tfsdk:"created_at"`
+	CronConfig  types.String               `tfsdk:"cron_config"`
+	Enable      types.Bool                 `tfsdk:"enable"`
+	IsManual    types.Bool                 `tfsdk:"is_manual"`
+	Mode        types.String               `tfsdk:"mode"`
+	Name        types.String               `tfsdk:"name"`
+	Plan        jsontypes.Normalized       `tfsdk:"plan"`
+	ProjectName types.String               `tfsdk:"project_name"`
+	SkipOnFail  types.Bool                 `tfsdk:"skip_on_fail"`
+	TimeAfter   timetypes.RFC3339          `tfsdk:"time_after"`
+	UpdatedAt   types.String               `tfsdk:"updated_at"`
+	Timeouts    timeouts.Value             `tfsdk:"timeouts"`
+}
+
+// blueprintConnectionModel maps the connection schema data of a blueprint.
+type blueprintConnectionModel struct {
+	ConnectionId types.String `tfsdk:"connection_id"`
+	PluginName   types.String `tfsdk:"plugin_name"`
+	ScopeIds     types.Set    `tfsdk:"scope_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *blueprintResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint"
+}
+
+// Schema defines the schema for the resource.
+func (r *blueprintResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the blueprint. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the blueprint.",
+			},
+			"connections": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The connections and their scopes the blueprint collects data from. Only used in 'NORMAL' mode.",
+				Optional:    true,
+				Default: setdefault.StaticValue(types.SetValueMust(
+					types.ObjectType{AttrTypes: map[string]attr.Type{
+						"connection_id": types.StringType,
+						"plugin_name":   types.StringType,
+						"scope_ids":     types.SetType{ElemType: types.StringType},
+					}},
+					[]attr.Value{},
+				)),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_id": schema.StringAttribute{
+							Description: "The id of the connection, e.g. the id of a 'devlake_github_connection'.",
+							Required:    true,
+						},
+						"plugin_name": schema.StringAttribute{
+							Description: "The name of the plugin the connection belongs to, e.g. 'github' or 'bitbucket_server'.",
+							Required:    true,
+						},
+						"scope_ids": schema.SetAttribute{
+							Description: "The ids of the connection scopes to collect, e.g. the ids of 'devlake_github_connection_scope' resources.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the blueprint was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cron_config": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("0 0 * * *"),
+				Description: "The cron schedule the blueprint is run with. Defaults to '0 0 * * *'.",
+				Optional:    true,
+			},
+			"enable": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the blueprint is run on its schedule. Defaults to 'true'.",
+				Optional:    true,
+			},
+			"is_manual": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the blueprint is only run when triggered manually, the cron schedule is ignored then. Defaults to 'false'.",
+				Optional:    true,
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("NORMAL"),
+				Description: "The blueprint mode, either 'NORMAL' or 'ADVANCED'. In 'NORMAL' mode devlake generates the plan from the connections, in 'ADVANCED' mode the plan has to be provided. Defaults to 'NORMAL'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("NORMAL", "ADVANCED"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the blueprint.",
+				Required:    true,
+			},
+			"plan": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The pipeline plan as JSON, a list of stages which are lists of plugin tasks. Required in 'ADVANCED' mode.",
+				Optional:    true,
+			},
+			"project_name": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The name of the project the blueprint belongs to. A project can only have one blueprint and devlake creates it along with the project, use the 'blueprint_id' of a 'devlake_project' to import that one instead.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"skip_on_fail": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to continue with the remaining tasks when a task of the pipeline fails. Defaults to 'false'.",
+				Optional:    true,
+			},
+			"time_after": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Only data created after this RFC3339 timestamp is collected.",
+				Optional:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the blueprint was updated in devlake.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig ensures a plan is only set in advanced mode.
+func (r *blueprintResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var mode types.String
+	var plan jsontypes.Normalized
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mode"), &mode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("plan"), &plan)...)
+	if resp.Diagnostics.HasError() || mode.IsUnknown() || plan.IsUnknown() {
+		return
+	}
+
+	if mode.ValueString() == "ADVANCED" && plan.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plan"),
+			"Missing blueprint plan",
+			"A plan is required when the blueprint mode is 'ADVANCED'.",
+		)
+	}
+	if mode.ValueString() != "ADVANCED" && !plan.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plan"),
+			"Unexpected blueprint plan",
+			"A plan can only be set when the blueprint mode is 'ADVANCED', in 'NORMAL' mode devlake generates the plan from the connections.",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *blueprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan blueprintResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	connections, diags := blueprintConnectionsFromModel(ctx, plan.Connections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var blueprintCreate = client.Blueprint{
+		Connections: connections,
+		CronConfig:  plan.CronConfig.ValueString(),
+		Enable:      plan.Enable.ValueBool(),
+		IsManual:    plan.IsManual.ValueBool(),
+		Mode:        plan.Mode.ValueString(),
+		Name:        plan.Name.ValueString(),
+		ProjectName: plan.ProjectName.ValueString(),
+		SkipOnFail:  plan.SkipOnFail.ValueBool(),
+		TimeAfter:   plan.TimeAfter.ValueStringPointer(),
+	}
+	if !plan.Plan.IsNull() {
+		blueprintCreate.Plan = json.RawMessage(plan.Plan.ValueString())
+	}
+
+	// Create new blueprint
+	blueprint, err := r.client.CreateBlueprint(ctx, blueprintCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake blueprint",
+			"Could not create devlake blueprint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	connectionsVal, diags := blueprintConnectionsToModel(ctx, blueprint.Connections)
+	resp.Diagnostics.Append(diags...)
+	timeAfterVal, diags := blueprintTimeAfterToModel(blueprint.TimeAfter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(strconv.Itoa(blueprint.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Connections = connectionsVal
+	plan.CreatedAt = types.StringValue(blueprint.CreatedAt)
+	plan.CronConfig = types.StringValue(blueprint.CronConfig)
+	plan.Enable = types.BoolValue(blueprint.Enable)
+	plan.IsManual = types.BoolValue(blueprint.IsManual)
+	plan.Mode = types.StringValue(blueprint.Mode)
+	plan.Name = types.StringValue(blueprint.Name)
+	if blueprint.Mode == "ADVANCED" {
+		plan.Plan = jsontypes.NewNormalizedValue(string(blueprint.Plan))
+	}
+	plan.ProjectName = types.StringValue(blueprint.ProjectName)
+	plan.SkipOnFail = types.BoolValue(blueprint.SkipOnFail)
+	plan.TimeAfter = timeAfterVal
+	plan.UpdatedAt = types.StringValue(blueprint.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *blueprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state blueprintResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed blueprint value from Devlake
+	blueprint, err := r.client.ReadBlueprint(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake blueprint",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite blueprint with refreshed state
+	connectionsVal, diags := blueprintConnectionsToModel(ctx, blueprint.Connections)
+	resp.Diagnostics.Append(diags...)
+	timeAfterVal, diags := blueprintTimeAfterToModel(blueprint.TimeAfter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = types.StringValue(strconv.Itoa(blueprint.ID))
+	state.Connections = connectionsVal
+	state.CreatedAt = types.StringValue(blueprint.CreatedAt)
+	state.CronConfig = types.StringValue(blueprint.CronConfig)
+	state.Enable = types.BoolValue(blueprint.Enable)
+	state.IsManual = types.BoolValue(blueprint.IsManual)
+	state.Mode = types.StringValue(blueprint.Mode)
+	state.Name = types.StringValue(blueprint.Name)
+	if blueprint.Mode == "ADVANCED" {
+		state.Plan = jsontypes.NewNormalizedValue(string(blueprint.Plan))
+	} else {
+		state.Plan = jsontypes.NewNormalizedNull()
+	}
+	state.ProjectName = types.StringValue(blueprint.ProjectName)
+	state.SkipOnFail = types.BoolValue(blueprint.SkipOnFail)
+	state.TimeAfter = timeAfterVal
+	state.UpdatedAt = types.StringValue(blueprint.UpdatedAt)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *blueprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan blueprintResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake blueprint",
+			"Could not update devlake blueprint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	connections, diags := blueprintConnectionsFromModel(ctx, plan.Connections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var blueprintUpdate = client.Blueprint{
+		ID:          id,
+		Connections: connections,
+		CronConfig:  plan.CronConfig.ValueString(),
+		Enable:      plan.Enable.ValueBool(),
+		IsManual:    plan.IsManual.ValueBool(),
+		Mode:        plan.Mode.ValueString(),
+		Name:        plan.Name.ValueString(),
+		ProjectName: plan.ProjectName.ValueString(),
+		SkipOnFail:  plan.SkipOnFail.ValueBool(),
+		TimeAfter:   plan.TimeAfter.ValueStringPointer(),
+	}
+	if !plan.Plan.IsNull() {
+		blueprintUpdate.Plan = json.RawMessage(plan.Plan.ValueString())
+	}
+
+	// Update existing blueprint
+	updatedBlueprint, err := r.client.UpdateBlueprint(ctx, plan.ID.ValueString(), blueprintUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake blueprint",
+			"Could not update devlake blueprint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	connectionsVal, diags := blueprintConnectionsToModel(ctx, updatedBlueprint.Connections)
+	resp.Diagnostics.Append(diags...)
+	timeAfterVal, diags := blueprintTimeAfterToModel(updatedBlueprint.TimeAfter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(strconv.Itoa(updatedBlueprint.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Connections = connectionsVal
+	plan.CreatedAt = types.StringValue(updatedBlueprint.CreatedAt)
+	plan.CronConfig = types.StringValue(updatedBlueprint.CronConfig)
+	plan.Enable = types.BoolValue(updatedBlueprint.Enable)
+	plan.IsManual = types.BoolValue(updatedBlueprint.IsManual)
+	plan.Mode = types.StringValue(updatedBlueprint.Mode)
+	plan.Name = types.StringValue(updatedBlueprint.Name)
+	if updatedBlueprint.Mode == "ADVANCED" {
+		plan.Plan = jsontypes.NewNormalizedValue(string(updatedBlueprint.Plan))
+	}
+	plan.ProjectName = types.StringValue(updatedBlueprint.ProjectName)
+	plan.SkipOnFail = types.BoolValue(updatedBlueprint.SkipOnFail)
+	plan.TimeAfter = timeAfterVal
+	plan.UpdatedAt = types.StringValue(updatedBlueprint.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *blueprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state blueprintResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing blueprint
+	err := r.client.DeleteBlueprint(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake blueprint",
+			"Could not delete devlake blueprint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *blueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *blueprintResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// blueprintConnectionsFromModel converts the connections of the schema to the
+// connections the devlake api expects.
+func blueprintConnectionsFromModel(ctx context.Context, connections []blueprintConnectionModel) ([]client.BlueprintConnection, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	blueprintConnections := []client.BlueprintConnection{}
+	for _, connection := range connections {
+		connectionId, err := strconv.Atoi(connection.ConnectionId.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid blueprint connection",
+				"Could not parse connection id, unexpected error: "+err.Error(),
+			)
+			return nil, diags
+		}
+		var scopeIds []string
+		diags.Append(connection.ScopeIds.ElementsAs(ctx, &scopeIds, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		scopes := []client.BlueprintScope{}
+		for _, scopeId := range scopeIds {
+			scopes = append(scopes, client.BlueprintScope{ScopeId: scopeId})
+		}
+		blueprintConnections = append(blueprintConnections, client.BlueprintConnection{
+			ConnectionId: connectionId,
+			PluginName:   connection.PluginName.ValueString(),
+			Scopes:       scopes,
+		})
+	}
+	return blueprintConnections, diags
+}
+
+// blueprintConnectionsToModel converts the connections of the devlake api to
+// the connections of the schema.
+func blueprintConnectionsToModel(ctx context.Context, blueprintConnections []client.BlueprintConnection) ([]blueprintConnectionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	connections := []blueprintConnectionModel{}
+	for _, blueprintConnection := range blueprintConnections {
+		scopeIds := []string{}
+		for _, scope := range blueprintConnection.Scopes {
+			scopeIds = append(scopeIds, scope.ScopeId)
+		}
+		scopeIdsVal, d := types.SetValueFrom(ctx, types.StringType, scopeIds)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		connections = append(connections, blueprintConnectionModel{
+			ConnectionId: types.StringValue(strconv.Itoa(blueprintConnection.ConnectionId)),
+			PluginName:   types.StringValue(blueprintConnection.PluginName),
+			ScopeIds:     scopeIdsVal,
+		})
+	}
+	return connections, diags
+}
+
+// blueprintTimeAfterToModel converts the optional time after of the devlake
+// api to the schema value.
+func blueprintTimeAfterToModel(timeAfter *string) (timetypes.RFC3339, diag.Diagnostics) {
+	if timeAfter == nil || *timeAfter == "" {
+		return timetypes.NewRFC3339Null(), nil
+	}
+	return timetypes.NewRFC3339PointerValue(timeAfter)
+}
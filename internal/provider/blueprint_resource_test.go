@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	blueprintConfig = githubConnectionScopeConfig + `
+resource "devlake_blueprint" "bp" {
+  name         = "should_not_exist"
+  cron_config  = "0 0 * * 1"
+  time_after   = "2025-01-01T00:00:00Z"
+  skip_on_fail = true
+  connections = [
+    {
+      plugin_name   = "github"
+      connection_id = devlake_github_connection.gh.id
+      scope_ids     = [devlake_github_connection_scope.scope.id]
+    },
+  ]
+}
+`
+)
+
+func TestAccBlueprintResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: blueprintConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "connections.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_blueprint.bp", "connections.*", map[string]string{
+						"plugin_name": "github",
+						"scope_ids.#": "1",
+						"scope_ids.0": "42",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("devlake_blueprint.bp", "connections.*.connection_id", "devlake_github_connection.gh", "id"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "cron_config", "0 0 * * 1"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "enable", "true"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "is_manual", "false"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "mode", "NORMAL"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "project_name", ""),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "skip_on_fail", "true"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "time_after", "2025-01-01T00:00:00Z"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_blueprint.bp", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_blueprint.bp", "id"),
+					resource.TestCheckResourceAttrSet("devlake_blueprint.bp", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_blueprint.bp", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devlake_blueprint.bp",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: githubConnectionScopeConfig + `
+resource "devlake_blueprint" "bp" {
+  name         = "should_not_exist"
+  cron_config  = "0 0 * * 1"
+  is_manual    = true
+  connections  = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "connections.#", "0"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "cron_config", "0 0 * * 1"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "is_manual", "true"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "mode", "NORMAL"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_blueprint.bp", "skip_on_fail", "false"),
+					resource.TestCheckNoResourceAttr("devlake_blueprint.bp", "time_after"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_blueprint.bp", "id"),
+					resource.TestCheckResourceAttrSet("devlake_blueprint.bp", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
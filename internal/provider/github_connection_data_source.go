@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &githubConnectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &githubConnectionDataSource{}
+)
+
+// NewGithubConnectionDataSource is a helper function to simplify the provider implementation.
+func NewGithubConnectionDataSource() datasource.DataSource {
+	return &githubConnectionDataSource{}
+}
+
+// githubConnectionDataSource is the data source implementation.
+type githubConnectionDataSource struct {
+	client *client.Client
+}
+
+// githubConnectionDataSourceModel maps the data source schema data. The
+// credentials of the connection are omitted.
+type githubConnectionDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AppId            types.Int64  `tfsdk:"app_id"`
+	AuthMethod       types.String `tfsdk:"auth_method"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	EnableGraphql    types.Bool   `tfsdk:"enable_graphql"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	InstallationId   types.Int64  `tfsdk:"installation_id"`
+	Name             types.String `tfsdk:"name"`
+	Proxy            types.String `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64  `tfsdk:"rate_limit_per_hour"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *githubConnectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_github_connection"
+}
+
+// Schema defines the schema for the data source.
+func (d *githubConnectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := githubConnectionDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Numeric identifier of the connection to look up. This is a string to match the connection_id of scopes. Exactly one of id and name must be set.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The name of the github connection to look up. Exactly one of id and name must be set.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing github connection by id or name, e.g. one created in the devlake config-ui. The credentials of the connection are not exposed.",
+		Attributes:  attributes,
+	}
+}
+
+// githubConnectionDataSourceAttributes returns the computed attributes of a
+// github connection, shared by the singular and the plural data source.
+func githubConnectionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Numeric identifier for the connection. This is a string to match the connection_id of scopes.",
+		},
+		"app_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The app id of the github app used for authentication, null for auth method 'AccessToken'.",
+		},
+		"auth_method": schema.StringAttribute{
+			Computed:    true,
+			Description: "The authentication method, either 'AppKey' for a github app or 'AccessToken' for personal access tokens.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the connection was created in devlake.",
+		},
+		"enable_graphql": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether to use the faster graphql api endpoints.",
+		},
+		"endpoint": schema.StringAttribute{
+			Computed:    true,
+			Description: "The base endpoint URL.",
+		},
+		"installation_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The installation id of the github app used for authentication, null for auth method 'AccessToken'.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the github connection.",
+		},
+		"proxy": schema.StringAttribute{
+			Computed:    true,
+			Description: "The proxy server used to reach github.",
+		},
+		"rate_limit_per_hour": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The rate limit devlake uses to collect github data.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the connection was updated in devlake.",
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *githubConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config githubConnectionDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var githubConnection *client.GithubConnection
+	if !config.ID.IsNull() {
+		connection, err := d.client.ReadGithubConnection(ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read devlake github connection",
+				"Could not read devlake github connection with id "+config.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		githubConnection = connection
+	} else {
+		connections, err := d.client.ListGithubConnections(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read devlake github connections",
+				err.Error(),
+			)
+			return
+		}
+		for _, connection := range connections {
+			if connection.Name == config.Name.ValueString() {
+				githubConnection = &connection
+				break
+			}
+		}
+		if githubConnection == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Devlake github connection not found",
+				"There is no devlake github connection named "+config.Name.ValueString()+".",
+			)
+			return
+		}
+	}
+
+	// Map response body to model
+	state, err := githubConnectionToDataSourceModel(githubConnection)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake github connection",
+			"Could not parse app id of devlake github connection: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// githubConnectionToDataSourceModel maps a github connection returned by
+// devlake to the data source model.
+func githubConnectionToDataSourceModel(connection *client.GithubConnection) (githubConnectionDataSourceModel, error) {
+	appId, err := githubAppIdToModel(connection.AppId)
+	if err != nil {
+		return githubConnectionDataSourceModel{}, err
+	}
+
+	return githubConnectionDataSourceModel{
+		ID:               types.StringValue(strconv.Itoa(connection.ID)),
+		AppId:            appId,
+		AuthMethod:       types.StringValue(connection.AuthMethod),
+		CreatedAt:        types.StringValue(connection.CreatedAt),
+		EnableGraphql:    types.BoolValue(connection.EnableGraphql),
+		Endpoint:         types.StringValue(connection.Endpoint),
+		InstallationId:   githubInstallationIdToModel(connection.InstallationId),
+		Name:             types.StringValue(connection.Name),
+		Proxy:            types.StringValue(connection.Proxy),
+		RateLimitPerHour: types.Int64Value(int64(connection.RateLimitPerHour)),
+		UpdatedAt:        types.StringValue(connection.UpdatedAt),
+	}, nil
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *githubConnectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	githubConnectionDataSourceConfig = githubConnectionConfig + `
+data "devlake_github_connection" "by_name" {
+  name = devlake_github_connection.gh.name
+}
+
+data "devlake_github_connection" "by_id" {
+  id = devlake_github_connection.gh.id
+}
+`
+)
+
+func TestAccGithubConnectionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: githubConnectionDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.devlake_github_connection.by_name", "id", "devlake_github_connection.gh", "id"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection.by_name", "app_id", "123123"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection.by_name", "auth_method", "AppKey"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection.by_name", "enable_graphql", "true"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection.by_name", "endpoint", "https://api.github.com/"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection.by_name", "installation_id", "321321"),
+					resource.TestCheckNoResourceAttr("data.devlake_github_connection.by_name", "secret_key"),
+					resource.TestCheckNoResourceAttr("data.devlake_github_connection.by_name", "token"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection.by_id", "name", "should_not_exist"),
+					resource.TestCheckResourceAttrSet("data.devlake_github_connection.by_id", "created_at"),
+				),
+			},
+		},
+	})
+}
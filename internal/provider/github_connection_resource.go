@@ -10,6 +10,8 @@ import (
 
 	"terraform-provider-devlake/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,14 +19,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &githubConnectionResource{}
-	_ resource.ResourceWithConfigure   = &githubConnectionResource{}
-	_ resource.ResourceWithImportState = &githubConnectionResource{}
+	_ resource.Resource                   = &githubConnectionResource{}
+	_ resource.ResourceWithConfigure      = &githubConnectionResource{}
+	_ resource.ResourceWithImportState    = &githubConnectionResource{}
+	_ resource.ResourceWithValidateConfig = &githubConnectionResource{}
 )
 
 // NewGithubConnectionResource is a helper function to simplify the provider implementation.
@@ -39,20 +43,21 @@ type githubConnectionResource struct {
 
 // githubConnectionResourceModel maps the resource schema data.
 type githubConnectionResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	LastUpdated      types.String `tfsdk:"last_updated"`
-	AppId            types.Int64  `tfsdk:"app_id"`
-	AuthMethod       types.String `tfsdk:"auth_method"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	EnableGraphql    types.Bool   `tfsdk:"enable_graphql"`
-	Endpoint         types.String `tfsdk:"endpoint"`
-	InstallationId   types.Int64  `tfsdk:"installation_id"`
-	Name             types.String `tfsdk:"name"`
-	Proxy            types.String `tfsdk:"proxy"`
-	RateLimitPerHour types.Int64  `tfsdk:"rate_limit_per_hour"`
-	SecretKey        types.String `tfsdk:"secret_key"`
-	Token            types.String `tfsdk:"token"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	AppId            types.Int64    `tfsdk:"app_id"`
+	AuthMethod       types.String   `tfsdk:"auth_method"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	EnableGraphql    types.Bool     `tfsdk:"enable_graphql"`
+	Endpoint         types.String   `tfsdk:"endpoint"`
+	InstallationId   types.Int64    `tfsdk:"installation_id"`
+	Name             types.String   `tfsdk:"name"`
+	Proxy            types.String   `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64    `tfsdk:"rate_limit_per_hour"`
+	SecretKey        types.String   `tfsdk:"secret_key"`
+	Token            types.String   `tfsdk:"token"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -61,7 +66,7 @@ func (r *githubConnectionResource) Metadata(_ context.Context, req resource.Meta
 }
 
 // Schema defines the schema for the resource.
-func (r *githubConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *githubConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,14 +81,17 @@ func (r *githubConnectionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "Timestamp of the last Terraform update of the connection.",
 			},
 			"app_id": schema.Int64Attribute{
-				Description: "The app id of the github app used for authentication.",
-				Required:    true,
+				Description: "The app id of the github app used for authentication, required for auth method 'AppKey'.",
+				Optional:    true,
 			},
 			"auth_method": schema.StringAttribute{
 				Computed:    true,
 				Default:     stringdefault.StaticString("AppKey"),
-				Description: "The authentication method. Currently only supports 'AppKey'.",
+				Description: "The authentication method, either 'AppKey' for a github app or 'AccessToken' for personal access tokens. Defaults to 'AppKey'.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AppKey", "AccessToken"),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
@@ -102,8 +110,8 @@ func (r *githubConnectionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Optional:    true,
 			},
 			"installation_id": schema.Int64Attribute{
-				Description: "The installation id of the github app used for authentication.",
-				Required:    true,
+				Description: "The installation id of the github app used for authentication, required for auth method 'AppKey'.",
+				Optional:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the github connection.",
@@ -120,14 +128,14 @@ func (r *githubConnectionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:    true,
 			},
 			"secret_key": schema.StringAttribute{
-				Description: "Github app private key used for authentication.",
-				Required:    true,
+				Description: "Github app private key used for authentication, required for auth method 'AppKey'.",
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"token": schema.StringAttribute{
 				Computed:    true,
 				Default:     stringdefault.StaticString(""),
-				Description: "PAT for github authentication. Currently not supported.",
+				Description: "Personal access token used for authentication, required for auth method 'AccessToken'. Multiple comma-separated tokens can be given to pool their rate limits.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -136,6 +144,78 @@ func (r *githubConnectionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "When the connection was updated in devlake.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig ensures the credentials match the auth method.
+func (r *githubConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config githubConnectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.AuthMethod.IsUnknown() {
+		return
+	}
+
+	if config.AuthMethod.ValueString() == "AccessToken" {
+		if config.Token.IsNull() || (!config.Token.IsUnknown() && config.Token.ValueString() == "") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Missing github access token",
+				"A token is required when the auth method is 'AccessToken'.",
+			)
+		}
+		if !config.AppId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("app_id"),
+				"Unexpected github app id",
+				"An app id can only be set when the auth method is 'AppKey'.",
+			)
+		}
+		if !config.InstallationId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("installation_id"),
+				"Unexpected github installation id",
+				"An installation id can only be set when the auth method is 'AppKey'.",
+			)
+		}
+		if !config.SecretKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("secret_key"),
+				"Unexpected github secret key",
+				"A secret key can only be set when the auth method is 'AppKey'.",
+			)
+		}
+		return
+	}
+
+	// AppKey is the default when auth_method is not configured
+	if config.AppId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("app_id"),
+			"Missing github app id",
+			"An app id is required when the auth method is 'AppKey'.",
+		)
+	}
+	if config.InstallationId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("installation_id"),
+			"Missing github installation id",
+			"An installation id is required when the auth method is 'AppKey'.",
+		)
+	}
+	if config.SecretKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_key"),
+			"Missing github secret key",
+			"A secret key is required when the auth method is 'AppKey'.",
+		)
 	}
 }
 
@@ -149,11 +229,19 @@ func (r *githubConnectionResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	now := time.Now().Format(time.RFC850)
 
 	// Generate API request body from plan
 	var githubConnectionCreate = client.GithubConnection{
-		AppId:            strconv.Itoa(int(plan.AppId.ValueInt64())),
+		AppId:            githubAppIdFromModel(plan.AppId),
 		AuthMethod:       plan.AuthMethod.ValueString(),
 		CreatedAt:        now,
 		EnableGraphql:    plan.EnableGraphql.ValueBool(),
@@ -163,12 +251,12 @@ func (r *githubConnectionResource) Create(ctx context.Context, req resource.Crea
 		Proxy:            plan.Proxy.ValueString(),
 		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
 		SecretKey:        plan.SecretKey.ValueString(),
-		// not passing in token
-		UpdatedAt: now,
+		Token:            plan.Token.ValueString(),
+		UpdatedAt:        now,
 	}
 
 	// Create new githubconnection
-	githubConnection, err := r.client.CreateGithubConnection(githubConnectionCreate)
+	githubConnection, err := r.client.CreateGithubConnection(ctx, githubConnectionCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake github connection",
@@ -177,8 +265,9 @@ func (r *githubConnectionResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	// Map response body to schema and populate Computed attribute values
-	appId, err := strconv.Atoi(githubConnection.AppId)
+	// Map response body to schema and populate Computed attribute values, the
+	// secrets are sanitized by devlake and are kept as configured
+	appId, err := githubAppIdToModel(githubConnection.AppId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake github connection",
@@ -188,16 +277,15 @@ func (r *githubConnectionResource) Create(ctx context.Context, req resource.Crea
 	}
 	plan.ID = types.StringValue(strconv.Itoa(githubConnection.ID))
 	plan.LastUpdated = types.StringValue(now)
-	plan.AppId = types.Int64Value(int64(appId))
+	plan.AppId = appId
 	plan.AuthMethod = types.StringValue(githubConnection.AuthMethod)
 	plan.CreatedAt = types.StringValue(githubConnection.CreatedAt)
 	plan.EnableGraphql = types.BoolValue(githubConnection.EnableGraphql)
 	plan.Endpoint = types.StringValue(githubConnection.Endpoint)
-	plan.InstallationId = types.Int64Value(int64(githubConnection.InstallationId))
+	plan.InstallationId = githubInstallationIdToModel(githubConnection.InstallationId)
 	plan.Name = types.StringValue(githubConnection.Name)
 	plan.Proxy = types.StringValue(githubConnection.Proxy)
 	plan.RateLimitPerHour = types.Int64Value(int64(githubConnection.RateLimitPerHour))
-	plan.Token = types.StringValue(githubConnection.Token)
 	plan.UpdatedAt = types.StringValue(githubConnection.UpdatedAt)
 
 	// Set state to fully populated data
@@ -218,9 +306,23 @@ func (r *githubConnectionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed github connection value from Devlake
-	githubConnection, err := r.client.ReadGithubConnection(state.ID.ValueString())
+	githubConnection, err := r.client.ReadGithubConnection(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read devlake github connection",
 			err.Error(),
@@ -228,8 +330,9 @@ func (r *githubConnectionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	// Overwrite connection with refreshed state
-	appId, err := strconv.Atoi(githubConnection.AppId)
+	// Overwrite connection with refreshed state, the secrets are sanitized by
+	// devlake and are kept as is
+	appId, err := githubAppIdToModel(githubConnection.AppId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading devlake github connection",
@@ -238,16 +341,15 @@ func (r *githubConnectionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 	state.ID = types.StringValue(strconv.Itoa(githubConnection.ID))
-	state.AppId = types.Int64Value(int64(appId))
+	state.AppId = appId
 	state.AuthMethod = types.StringValue(githubConnection.AuthMethod)
 	state.CreatedAt = types.StringValue(githubConnection.CreatedAt)
 	state.EnableGraphql = types.BoolValue(githubConnection.EnableGraphql)
 	state.Endpoint = types.StringValue(githubConnection.Endpoint)
-	state.InstallationId = types.Int64Value(int64(githubConnection.InstallationId))
+	state.InstallationId = githubInstallationIdToModel(githubConnection.InstallationId)
 	state.Name = types.StringValue(githubConnection.Name)
 	state.Proxy = types.StringValue(githubConnection.Proxy)
 	state.RateLimitPerHour = types.Int64Value(int64(githubConnection.RateLimitPerHour))
-	state.Token = types.StringValue(githubConnection.Token)
 	state.UpdatedAt = types.StringValue(githubConnection.UpdatedAt)
 
 	// Set refreshed state
@@ -268,6 +370,14 @@ func (r *githubConnectionResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	id, err := strconv.Atoi(plan.ID.ValueString())
 	if err != nil {
@@ -279,7 +389,7 @@ func (r *githubConnectionResource) Update(ctx context.Context, req resource.Upda
 	}
 	var githubConnectionUpdate = client.GithubConnection{
 		ID:               id,
-		AppId:            strconv.Itoa(int(plan.AppId.ValueInt64())),
+		AppId:            githubAppIdFromModel(plan.AppId),
 		AuthMethod:       plan.AuthMethod.ValueString(),
 		CreatedAt:        plan.CreatedAt.ValueString(),
 		EnableGraphql:    plan.EnableGraphql.ValueBool(),
@@ -289,11 +399,12 @@ func (r *githubConnectionResource) Update(ctx context.Context, req resource.Upda
 		Proxy:            plan.Proxy.ValueString(),
 		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
 		SecretKey:        plan.SecretKey.ValueString(),
+		Token:            plan.Token.ValueString(),
 		UpdatedAt:        time.Now().Format(time.RFC850),
 	}
 
 	// Update existing connection
-	updatedGithubConnection, err := r.client.UpdateGithubConnection(plan.ID.ValueString(), githubConnectionUpdate)
+	updatedGithubConnection, err := r.client.UpdateGithubConnection(ctx, plan.ID.ValueString(), githubConnectionUpdate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating devlake github connection",
@@ -302,7 +413,7 @@ func (r *githubConnectionResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	appId, err := strconv.Atoi(updatedGithubConnection.AppId)
+	appId, err := githubAppIdToModel(updatedGithubConnection.AppId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading devlake github connection",
@@ -312,15 +423,15 @@ func (r *githubConnectionResource) Update(ctx context.Context, req resource.Upda
 	}
 	plan.ID = types.StringValue(strconv.Itoa(updatedGithubConnection.ID))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-	plan.AppId = types.Int64Value(int64(appId))
+	plan.AppId = appId
 	plan.AuthMethod = types.StringValue(updatedGithubConnection.AuthMethod)
 	plan.CreatedAt = types.StringValue(updatedGithubConnection.CreatedAt)
 	plan.EnableGraphql = types.BoolValue(updatedGithubConnection.EnableGraphql)
 	plan.Endpoint = types.StringValue(updatedGithubConnection.Endpoint)
+	plan.InstallationId = githubInstallationIdToModel(updatedGithubConnection.InstallationId)
 	plan.Name = types.StringValue(updatedGithubConnection.Name)
 	plan.Proxy = types.StringValue(updatedGithubConnection.Proxy)
 	plan.RateLimitPerHour = types.Int64Value(int64(updatedGithubConnection.RateLimitPerHour))
-	plan.Token = types.StringValue(updatedGithubConnection.Token)
 	plan.UpdatedAt = types.StringValue(updatedGithubConnection.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
@@ -340,8 +451,16 @@ func (r *githubConnectionResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing apikey
-	err := r.client.DeleteGithubConnection(state.ID.ValueString())
+	err := r.client.DeleteGithubConnection(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting devlake github connection",
@@ -376,3 +495,34 @@ func (r *githubConnectionResource) Configure(_ context.Context, req resource.Con
 
 	r.client = client
 }
+
+// githubAppIdFromModel converts the app id to the string expected by devlake,
+// which is empty when no github app is used.
+func githubAppIdFromModel(appId types.Int64) string {
+	if appId.IsNull() || appId.IsUnknown() {
+		return ""
+	}
+	return strconv.FormatInt(appId.ValueInt64(), 10)
+}
+
+// githubAppIdToModel converts the app id returned by devlake, an empty app id
+// is mapped to null.
+func githubAppIdToModel(appId string) (types.Int64, error) {
+	if appId == "" {
+		return types.Int64Null(), nil
+	}
+	id, err := strconv.ParseInt(appId, 10, 64)
+	if err != nil {
+		return types.Int64Null(), err
+	}
+	return types.Int64Value(id), nil
+}
+
+// githubInstallationIdToModel converts the installation id returned by
+// devlake, which is 0 when no github app is used.
+func githubInstallationIdToModel(installationId int) types.Int64 {
+	if installationId == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(installationId))
+}
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -94,3 +95,65 @@ resource "devlake_github_connection" "gh" {
 		},
 	})
 }
+
+func TestAccGithubConnectionResourceAccessToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing, github app credentials are not used with
+			// an access token.
+			{
+				Config: providerConfig + `
+resource "devlake_github_connection" "gh" {
+  auth_method     = "AccessToken"
+  name            = "should_not_exist"
+  token           = "ghp_token1"
+  app_id          = 123123
+  installation_id = 321321
+  secret_key      = "whatever"
+}
+`,
+				ExpectError: regexp.MustCompile("An app id can only be set when the auth method is 'AppKey'"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_github_connection" "gh" {
+  auth_method = "AccessToken"
+  name        = "should_not_exist"
+  token       = "ghp_token1,ghp_token2"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devlake_github_connection.gh", "app_id"),
+					resource.TestCheckResourceAttr("devlake_github_connection.gh", "auth_method", "AccessToken"),
+					resource.TestCheckNoResourceAttr("devlake_github_connection.gh", "installation_id"),
+					resource.TestCheckResourceAttr("devlake_github_connection.gh", "name", "should_not_exist"),
+					resource.TestCheckNoResourceAttr("devlake_github_connection.gh", "secret_key"),
+					resource.TestCheckResourceAttr("devlake_github_connection.gh", "token", "ghp_token1,ghp_token2"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_github_connection.gh", "id"),
+					resource.TestCheckResourceAttrSet("devlake_github_connection.gh", "last_updated"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_github_connection" "gh" {
+  auth_method = "AccessToken"
+  name        = "should_not_exist"
+  token       = "ghp_token3"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_github_connection.gh", "auth_method", "AccessToken"),
+					resource.TestCheckResourceAttr("devlake_github_connection.gh", "token", "ghp_token3"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_github_connection.gh", "id"),
+					resource.TestCheckResourceAttrSet("devlake_github_connection.gh", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -5,18 +5,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"terraform-provider-devlake/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -39,13 +44,14 @@ type githubConnectionScopeResource struct {
 
 // githubConnectionScopeResourceModel maps the resource schema data.
 type githubConnectionScopeResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	LastUpdated   types.String `tfsdk:"last_updated"`
-	ConnectionId  types.String `tfsdk:"connection_id"`
-	CreatedAt     types.String `tfsdk:"created_at"`
-	Description   types.String `tfsdk:"description"`
-	FullName      types.String `tfsdk:"full_name"`
-	ScopeConfigId types.String `tfsdk:"scope_config_id"`
+	ID            types.String   `tfsdk:"id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	ConnectionId  types.String   `tfsdk:"connection_id"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Description   types.String   `tfsdk:"description"`
+	FullName      types.String   `tfsdk:"full_name"`
+	ScopeConfigId types.String   `tfsdk:"scope_config_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -54,14 +60,16 @@ func (r *githubConnectionScopeResource) Metadata(_ context.Context, req resource
 }
 
 // Schema defines the schema for the resource.
-func (r *githubConnectionScopeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *githubConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The id of the repository in github.",
-				Required:    true,
+				Computed:    true,
+				Description: "The id of the repository in github. Resolved from the full_name via the remote scopes of the connection if not set, which requires the connection to have access to the repository.",
+				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -89,14 +97,32 @@ func (r *githubConnectionScopeResource) Schema(_ context.Context, _ resource.Sch
 				Optional:    true,
 			},
 			"full_name": schema.StringAttribute{
-				Description: "The Github org and repository in the format '<ORG>/<REPOSITORY>'.",
-				Required:    true,
+				Description: "The Github org and repository in the format '<ORG>/<REPOSITORY>'. Changing it replaces the scope unless the id is set, e.g. for a renamed repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						githubFullNameRequiresReplace,
+						"Without a configured id the repository is resolved from the full name, so a changed full name is another repository.",
+						"Without a configured id the repository is resolved from the full name, so a changed full name is another repository.",
+					),
+				},
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]+/[^/]+$`), "must be in the format '<ORG>/<REPOSITORY>'"),
+				},
 			},
 			"scope_config_id": schema.StringAttribute{
 				Description: "The config used for the scope. Needs to be created first.",
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -110,12 +136,20 @@ func (r *githubConnectionScopeResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
-	id, err := strconv.Atoi(plan.ID.ValueString())
+	repository, err := r.resolveRepository(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake github connection scope",
-			"Could not create devlake github connection scope, unexpected error: "+err.Error(),
+			"Could not resolve github repository "+plan.FullName.ValueString()+": "+err.Error(),
 		)
 		return
 	}
@@ -137,14 +171,14 @@ func (r *githubConnectionScopeResource) Create(ctx context.Context, req resource
 	}
 	now := time.Now().Format(time.RFC3339)
 	var githubConnectionScopeCreate = client.GithubConnectionScope{
-		GithubId:      id,
-		CloneUrl:      "https://github.com/" + plan.FullName.ValueString() + ".git",
+		GithubId:      repository.GithubId,
+		CloneUrl:      repository.CloneUrl,
 		ConnectionId:  connectionId,
 		CreatedAt:     now,
 		Description:   plan.Description.ValueString(),
 		FullName:      plan.FullName.ValueString(),
-		HTMLUrl:       "https://github.com/" + plan.FullName.ValueString(),
-		Name:          strings.Split(plan.FullName.ValueString(), "/")[1],
+		HTMLUrl:       repository.HTMLUrl,
+		Name:          repository.Name,
 		ScopeConfigId: scopeConfigId,
 		UpdatedAt:     now,
 		CreatedDate:   now,
@@ -152,7 +186,7 @@ func (r *githubConnectionScopeResource) Create(ctx context.Context, req resource
 	}
 
 	// Create new githubconnectionscope
-	githubConnectionScope, err := r.client.CreateGithubConnectionScope(plan.ConnectionId.ValueString(), githubConnectionScopeCreate)
+	githubConnectionScope, err := r.client.CreateGithubConnectionScope(ctx, plan.ConnectionId.ValueString(), githubConnectionScopeCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake github connection scope",
@@ -188,9 +222,23 @@ func (r *githubConnectionScopeResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed github connection scope value from Devlake
-	githubConnectionScope, err := r.client.ReadGithubConnectionScope(state.ConnectionId.ValueString(), state.ID.ValueString())
+	githubConnectionScope, err := r.client.ReadGithubConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read devlake github connection scope",
 			err.Error(),
@@ -224,12 +272,20 @@ func (r *githubConnectionScopeResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
-	id, err := strconv.Atoi(plan.ID.ValueString())
+	repository, err := r.resolveRepository(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating devlake github connection scope",
-			"Could not update devlake github connection scope, unexpected error: "+err.Error(),
+			"Could not resolve github repository "+plan.FullName.ValueString()+": "+err.Error(),
 		)
 		return
 	}
@@ -250,14 +306,14 @@ func (r *githubConnectionScopeResource) Update(ctx context.Context, req resource
 		return
 	}
 	var githubConnectionScopeUpdate = client.GithubConnectionScope{
-		GithubId:      id,
-		CloneUrl:      "https://github.com/" + plan.FullName.ValueString() + ".git",
+		GithubId:      repository.GithubId,
+		CloneUrl:      repository.CloneUrl,
 		ConnectionId:  connectionId,
 		CreatedAt:     plan.CreatedAt.ValueString(),
 		Description:   plan.Description.ValueString(),
 		FullName:      plan.FullName.ValueString(),
-		HTMLUrl:       "https://github.com/" + plan.FullName.ValueString(),
-		Name:          strings.Split(plan.FullName.ValueString(), "/")[1],
+		HTMLUrl:       repository.HTMLUrl,
+		Name:          repository.Name,
 		ScopeConfigId: scopeConfigId,
 		UpdatedAt:     time.Now().Format(time.RFC3339),
 		CreatedDate:   plan.CreatedAt.ValueString(),
@@ -265,7 +321,7 @@ func (r *githubConnectionScopeResource) Update(ctx context.Context, req resource
 	}
 
 	// Update existing connection scope
-	updatedGithubConnectionScope, err := r.client.UpdateGithubConnectionScope(plan.ConnectionId.ValueString(), plan.ID.ValueString(), githubConnectionScopeUpdate)
+	updatedGithubConnectionScope, err := r.client.UpdateGithubConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), githubConnectionScopeUpdate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating devlake github connection scope",
@@ -297,8 +353,16 @@ func (r *githubConnectionScopeResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing apikey
-	err := r.client.DeleteGithubConnectionScope(state.ConnectionId.ValueString(), state.ID.ValueString())
+	err := r.client.DeleteGithubConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting devlake github connection scope",
@@ -344,3 +408,79 @@ func (r *githubConnectionScopeResource) Configure(_ context.Context, req resourc
 
 	r.client = client
 }
+
+// resolveRepository returns the github id, name and urls of the repository of
+// the scope. Without a known id the repository is looked up by its full name
+// in the remote scopes of the connection, otherwise the urls are derived from
+// the endpoint of the connection.
+func (r *githubConnectionScopeResource) resolveRepository(ctx context.Context, plan githubConnectionScopeResourceModel) (*client.GithubConnectionScope, error) {
+	fullName := plan.FullName.ValueString()
+	owner, name, _ := strings.Cut(fullName, "/")
+
+	if plan.ID.IsNull() || plan.ID.IsUnknown() {
+		// Search for the repository first, listing all repositories of the
+		// owner for every scope quickly runs into the rate limits.
+		repositories, err := r.client.SearchGithubRemoteScopes(ctx, plan.ConnectionId.ValueString(), fullName)
+		if err != nil {
+			return nil, err
+		}
+		if repository := githubFindRepository(repositories, fullName); repository != nil {
+			return repository, nil
+		}
+		repositories, err = r.client.ListGithubRemoteScopes(ctx, plan.ConnectionId.ValueString(), owner)
+		if err != nil {
+			return nil, err
+		}
+		if repository := githubFindRepository(repositories, fullName); repository != nil {
+			return repository, nil
+		}
+		return nil, fmt.Errorf("the repository is not among the remote scopes of connection %s", plan.ConnectionId.ValueString())
+	}
+
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	connection, err := r.client.ReadGithubConnection(ctx, plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	webUrl := githubWebUrl(connection.Endpoint)
+	return &client.GithubConnectionScope{
+		GithubId: id,
+		CloneUrl: webUrl + fullName + ".git",
+		HTMLUrl:  webUrl + fullName,
+		Name:     name,
+	}, nil
+}
+
+// githubFindRepository returns the repository with the full name, github
+// treats the names case insensitive.
+func githubFindRepository(repositories []client.GithubConnectionScope, fullName string) *client.GithubConnectionScope {
+	for _, repository := range repositories {
+		if strings.EqualFold(repository.FullName, fullName) {
+			return &repository
+		}
+	}
+	return nil
+}
+
+// githubWebUrl derives the web url of a github instance from its api
+// endpoint, e.g. 'https://github.example.com/' for the github enterprise
+// endpoint 'https://github.example.com/api/v3/'.
+func githubWebUrl(endpoint string) string {
+	endpointUrl, err := url.Parse(endpoint)
+	if err != nil || endpointUrl.Host == "" || endpointUrl.Host == "api.github.com" {
+		return "https://github.com/"
+	}
+	basePath := strings.TrimSuffix(strings.TrimSuffix(endpointUrl.Path, "/"), "/api/v3")
+	return endpointUrl.Scheme + "://" + endpointUrl.Host + basePath + "/"
+}
+
+// githubFullNameRequiresReplace replaces the scope on a changed full name
+// unless the id of the repository is configured.
+func githubFullNameRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.RequiresReplace = id.IsNull()
+}
@@ -4,9 +4,12 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -89,3 +92,90 @@ resource "devlake_github_connection_scope" "scope" {
 		},
 	})
 }
+
+// githubConnectionScopeByFullNameConfig returns a scope which is resolved by
+// its full name, this needs a connection with a valid github token.
+func githubConnectionScopeByFullNameConfig(token, fullName string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devlake_github_connection" "gh" {
+  auth_method = "AccessToken"
+  name        = "should_not_exist"
+  token       = %q
+}
+
+resource "devlake_github_connection_scope" "scope" {
+  connection_id = devlake_github_connection.gh.id
+  full_name     = %q
+}
+`, token, fullName)
+}
+
+func TestAccGithubConnectionScopeResourceByFullName(t *testing.T) {
+	token := os.Getenv("DEVLAKE_TEST_GITHUB_TOKEN")
+	if token == "" {
+		t.Skip("DEVLAKE_TEST_GITHUB_TOKEN must be set to resolve repositories by their full name")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the id and urls are resolved
+			{
+				Config: githubConnectionScopeByFullNameConfig(token, "apache/incubator-devlake"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_github_connection_scope.scope", "clone_url", "https://github.com/apache/incubator-devlake.git"),
+					resource.TestCheckResourceAttr("devlake_github_connection_scope.scope", "full_name", "apache/incubator-devlake"),
+					resource.TestCheckResourceAttr("devlake_github_connection_scope.scope", "html_url", "https://github.com/apache/incubator-devlake"),
+					resource.TestMatchResourceAttr("devlake_github_connection_scope.scope", "id", regexp.MustCompile(`^[0-9]+$`)),
+				),
+			},
+			// Changing the full name without an id replaces the scope
+			{
+				Config: githubConnectionScopeByFullNameConfig(token, "apache/incubator-devlake-website"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devlake_github_connection_scope.scope", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_github_connection_scope.scope", "clone_url", "https://github.com/apache/incubator-devlake-website.git"),
+					resource.TestCheckResourceAttr("devlake_github_connection_scope.scope", "html_url", "https://github.com/apache/incubator-devlake-website"),
+					resource.TestMatchResourceAttr("devlake_github_connection_scope.scope", "id", regexp.MustCompile(`^[0-9]+$`)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestGithubWebUrl(t *testing.T) {
+	tests := map[string]struct {
+		endpoint string
+		want     string
+	}{
+		"github.com": {
+			endpoint: "https://api.github.com/",
+			want:     "https://github.com/",
+		},
+		"enterprise": {
+			endpoint: "https://github.example.com/api/v3/",
+			want:     "https://github.example.com/",
+		},
+		"enterprise without trailing slash": {
+			endpoint: "https://github.example.com/api/v3",
+			want:     "https://github.example.com/",
+		},
+		"malformed": {
+			endpoint: "://github.example.com",
+			want:     "https://github.com/",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := githubWebUrl(tt.endpoint); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,7 +11,9 @@ import (
 
 	"terraform-provider-devlake/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -43,8 +45,15 @@ type githubConnectionScopeConfigResource struct {
 
 // githubConnectionScopeConfigResourceModel maps the resource schema data.
 type githubConnectionScopeConfigResourceModel struct {
+	githubConnectionScopeConfigModel
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// githubConnectionScopeConfigModel maps the scope config attributes of
+// devlake, shared with the scope config of the scopes data source.
+type githubConnectionScopeConfigModel struct {
 	ID                   types.String `tfsdk:"id"`
-	LastUpdated          types.String `tfsdk:"last_updated"`
 	ConnectionId         types.String `tfsdk:"connection_id"`
 	CreatedAt            types.String `tfsdk:"created_at"`
 	DeploymentPattern    types.String `tfsdk:"deployment_pattern"`
@@ -71,7 +80,7 @@ func (r *githubConnectionScopeConfigResource) Metadata(_ context.Context, req re
 }
 
 // Schema defines the schema for the resource.
-func (r *githubConnectionScopeConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *githubConnectionScopeConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -223,6 +232,14 @@ func (r *githubConnectionScopeConfigResource) Schema(_ context.Context, _ resour
 				Description: "When the connection was updated in devlake.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -236,6 +253,14 @@ func (r *githubConnectionScopeConfigResource) Create(ctx context.Context, req re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var entities []string
 	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
@@ -279,7 +304,7 @@ func (r *githubConnectionScopeConfigResource) Create(ctx context.Context, req re
 	}
 
 	// Create new githubconnectionscopeconfig
-	githubConnectionScopeConfig, err := r.client.CreateGithubConnectionScopeConfig(plan.ConnectionId.ValueString(), githubConnectionScopeConfigCreate)
+	githubConnectionScopeConfig, err := r.client.CreateGithubConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), githubConnectionScopeConfigCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating devlake github connection scope config",
@@ -289,31 +314,11 @@ func (r *githubConnectionScopeConfigResource) Create(ctx context.Context, req re
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, githubConnectionScopeConfig.Entities)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(githubConnectionScopeConfigToModel(ctx, githubConnectionScopeConfig, &plan.githubConnectionScopeConfigModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.Entities = entitiesVal
-	plan.ID = types.StringValue(strconv.Itoa(githubConnectionScopeConfig.ID))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-	plan.CreatedAt = types.StringValue(githubConnectionScopeConfig.CreatedAt)
-	plan.DeploymentPattern = types.StringValue(githubConnectionScopeConfig.DeploymentPattern)
-	plan.EnvNamePattern = types.StringValue(githubConnectionScopeConfig.EnvNamePattern)
-	plan.IssueComponent = types.StringValue(githubConnectionScopeConfig.IssueComponent)
-	plan.IssuePriority = types.StringValue(githubConnectionScopeConfig.IssuePriority)
-	plan.IssueSeverity = types.StringValue(githubConnectionScopeConfig.IssueSeverity)
-	plan.IssueTypeBug = types.StringValue(githubConnectionScopeConfig.IssueTypeBug)
-	plan.IssueTypeIncident = types.StringValue(githubConnectionScopeConfig.IssueTypeIncident)
-	plan.IssueTypeRequirement = types.StringValue(githubConnectionScopeConfig.IssueTypeRequirement)
-	plan.Name = types.StringValue(githubConnectionScopeConfig.Name)
-	plan.PrBodyClosePattern = types.StringValue(githubConnectionScopeConfig.PrBodyClosePattern)
-	plan.PrComponent = types.StringValue(githubConnectionScopeConfig.PrComponent)
-	plan.PrType = types.StringValue(githubConnectionScopeConfig.PrType)
-	plan.ProductionPattern = types.StringValue(githubConnectionScopeConfig.ProductionPattern)
-	plan.RefDiff.TagsLimit = types.Int64Value(int64(githubConnectionScopeConfig.RefDiff.TagsLimit))
-	plan.RefDiff.TagsPattern = types.StringValue(githubConnectionScopeConfig.RefDiff.TagsPattern)
-	plan.UpdatedAt = types.StringValue(githubConnectionScopeConfig.UpdatedAt)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -333,9 +338,23 @@ func (r *githubConnectionScopeConfigResource) Read(ctx context.Context, req reso
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed github connection scope config value from Devlake
-	githubConnectionScopeConfig, err := r.client.ReadGithubConnectionScopeConfig(state.ConnectionId.ValueString(), state.ID.ValueString())
+	githubConnectionScopeConfig, err := r.client.ReadGithubConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read devlake github connection scopeconfig",
 			err.Error(),
@@ -344,36 +363,10 @@ func (r *githubConnectionScopeConfigResource) Read(ctx context.Context, req reso
 	}
 
 	// Overwrite github connection scope config with refreshed state
-	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, githubConnectionScopeConfig.Entities)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(githubConnectionScopeConfigToModel(ctx, githubConnectionScopeConfig, &state.githubConnectionScopeConfigModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.CreatedAt = types.StringValue(githubConnectionScopeConfig.CreatedAt)
-	state.DeploymentPattern = types.StringValue(githubConnectionScopeConfig.DeploymentPattern)
-	state.Entities = entitiesVal
-	state.EnvNamePattern = types.StringValue(githubConnectionScopeConfig.EnvNamePattern)
-	state.ID = types.StringValue(strconv.Itoa(githubConnectionScopeConfig.ID))
-	state.IssueComponent = types.StringValue(githubConnectionScopeConfig.IssueComponent)
-	state.IssuePriority = types.StringValue(githubConnectionScopeConfig.IssuePriority)
-	state.IssueSeverity = types.StringValue(githubConnectionScopeConfig.IssueSeverity)
-	state.IssueTypeBug = types.StringValue(githubConnectionScopeConfig.IssueTypeBug)
-	state.IssueTypeIncident = types.StringValue(githubConnectionScopeConfig.IssueTypeIncident)
-	state.IssueTypeRequirement = types.StringValue(githubConnectionScopeConfig.IssueTypeRequirement)
-	state.Name = types.StringValue(githubConnectionScopeConfig.Name)
-	state.PrBodyClosePattern = types.StringValue(githubConnectionScopeConfig.PrBodyClosePattern)
-	state.PrComponent = types.StringValue(githubConnectionScopeConfig.PrComponent)
-	state.PrType = types.StringValue(githubConnectionScopeConfig.PrType)
-	state.ProductionPattern = types.StringValue(githubConnectionScopeConfig.ProductionPattern)
-	state.UpdatedAt = types.StringValue(githubConnectionScopeConfig.UpdatedAt)
-	if apiRefDiff := githubConnectionScopeConfig.RefDiff; apiRefDiff != nil {
-		state.RefDiff = &refDiff{
-			TagsLimit:   types.Int64Value(int64(apiRefDiff.TagsLimit)),
-			TagsPattern: types.StringValue(apiRefDiff.TagsPattern),
-		}
-	} else {
-		state.RefDiff = nil
-	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -393,6 +386,14 @@ func (r *githubConnectionScopeConfigResource) Update(ctx context.Context, req re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
 	if err != nil {
@@ -443,7 +444,7 @@ func (r *githubConnectionScopeConfigResource) Update(ctx context.Context, req re
 	}
 
 	// Update existing connection scope config
-	updatedGithubConnectionScopeConfig, err := r.client.UpdateGithubConnectionScopeConfig(plan.ConnectionId.ValueString(), plan.ID.ValueString(), githubConnectionScopeConfigUpdate)
+	updatedGithubConnectionScopeConfig, err := r.client.UpdateGithubConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), githubConnectionScopeConfigUpdate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating devlake github connection scopeconfig",
@@ -452,29 +453,10 @@ func (r *githubConnectionScopeConfigResource) Update(ctx context.Context, req re
 		return
 	}
 
-	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, updatedGithubConnectionScopeConfig.Entities)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(githubConnectionScopeConfigToModel(ctx, updatedGithubConnectionScopeConfig, &plan.githubConnectionScopeConfigModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.CreatedAt = types.StringValue(updatedGithubConnectionScopeConfig.CreatedAt)
-	plan.DeploymentPattern = types.StringValue(updatedGithubConnectionScopeConfig.DeploymentPattern)
-	plan.Entities = entitiesVal
-	plan.EnvNamePattern = types.StringValue(updatedGithubConnectionScopeConfig.EnvNamePattern)
-	plan.IssueComponent = types.StringValue(updatedGithubConnectionScopeConfig.IssueComponent)
-	plan.IssuePriority = types.StringValue(updatedGithubConnectionScopeConfig.IssuePriority)
-	plan.IssueSeverity = types.StringValue(updatedGithubConnectionScopeConfig.IssueSeverity)
-	plan.IssueTypeBug = types.StringValue(updatedGithubConnectionScopeConfig.IssueTypeBug)
-	plan.IssueTypeIncident = types.StringValue(updatedGithubConnectionScopeConfig.IssueTypeIncident)
-	plan.IssueTypeRequirement = types.StringValue(updatedGithubConnectionScopeConfig.IssueTypeRequirement)
-	plan.Name = types.StringValue(updatedGithubConnectionScopeConfig.Name)
-	plan.PrBodyClosePattern = types.StringValue(updatedGithubConnectionScopeConfig.PrBodyClosePattern)
-	plan.PrComponent = types.StringValue(updatedGithubConnectionScopeConfig.PrComponent)
-	plan.PrType = types.StringValue(updatedGithubConnectionScopeConfig.PrType)
-	plan.ProductionPattern = types.StringValue(updatedGithubConnectionScopeConfig.ProductionPattern)
-	plan.RefDiff.TagsLimit = types.Int64Value(int64(updatedGithubConnectionScopeConfig.RefDiff.TagsLimit))
-	plan.RefDiff.TagsPattern = types.StringValue(updatedGithubConnectionScopeConfig.RefDiff.TagsPattern)
-	plan.UpdatedAt = types.StringValue(updatedGithubConnectionScopeConfig.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -493,8 +475,16 @@ func (r *githubConnectionScopeConfigResource) Delete(ctx context.Context, req re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing connection scope config
-	err := r.client.DeleteGithubConnectionScopeConfig(state.ConnectionId.ValueString(), state.ID.ValueString())
+	err := r.client.DeleteGithubConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting devlake github connection scopeconfig",
@@ -540,3 +530,32 @@ func (r *githubConnectionScopeConfigResource) Configure(_ context.Context, req r
 
 	r.client = client
 }
+
+// githubConnectionScopeConfigToModel maps a github scope config of devlake to
+// the model, it is used by the resource and the scopes data source.
+func githubConnectionScopeConfigToModel(ctx context.Context, scopeConfig *client.GithubConnectionScopeConfig, model *githubConnectionScopeConfigModel) diag.Diagnostics {
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, scopeConfig.Entities)
+	if diags.HasError() {
+		return diags
+	}
+	model.ID = types.StringValue(strconv.Itoa(scopeConfig.ID))
+	model.ConnectionId = types.StringValue(strconv.Itoa(scopeConfig.ConnectionId))
+	model.CreatedAt = types.StringValue(scopeConfig.CreatedAt)
+	model.DeploymentPattern = types.StringValue(scopeConfig.DeploymentPattern)
+	model.Entities = entitiesVal
+	model.EnvNamePattern = types.StringValue(scopeConfig.EnvNamePattern)
+	model.IssueComponent = types.StringValue(scopeConfig.IssueComponent)
+	model.IssuePriority = types.StringValue(scopeConfig.IssuePriority)
+	model.IssueSeverity = types.StringValue(scopeConfig.IssueSeverity)
+	model.IssueTypeBug = types.StringValue(scopeConfig.IssueTypeBug)
+	model.IssueTypeIncident = types.StringValue(scopeConfig.IssueTypeIncident)
+	model.IssueTypeRequirement = types.StringValue(scopeConfig.IssueTypeRequirement)
+	model.Name = types.StringValue(scopeConfig.Name)
+	model.PrBodyClosePattern = types.StringValue(scopeConfig.PrBodyClosePattern)
+	model.PrComponent = types.StringValue(scopeConfig.PrComponent)
+	model.PrType = types.StringValue(scopeConfig.PrType)
+	model.ProductionPattern = types.StringValue(scopeConfig.ProductionPattern)
+	model.RefDiff = refDiffToModel(scopeConfig.RefDiff)
+	model.UpdatedAt = types.StringValue(scopeConfig.UpdatedAt)
+	return diags
+}
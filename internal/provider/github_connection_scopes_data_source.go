@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &githubConnectionScopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &githubConnectionScopesDataSource{}
+)
+
+// NewGithubConnectionScopesDataSource is a helper function to simplify the provider implementation.
+func NewGithubConnectionScopesDataSource() datasource.DataSource {
+	return &githubConnectionScopesDataSource{}
+}
+
+// githubConnectionScopesDataSource is the data source implementation.
+type githubConnectionScopesDataSource struct {
+	client *client.Client
+}
+
+// githubConnectionScopesDataSourceModel maps the data source schema data.
+type githubConnectionScopesDataSourceModel struct {
+	ConnectionId types.String                           `tfsdk:"connection_id"`
+	Scopes       []githubConnectionScopeDataSourceModel `tfsdk:"scopes"`
+	SearchTerm   types.String                           `tfsdk:"search_term"`
+}
+
+// githubConnectionScopeDataSourceModel maps the scope schema data.
+type githubConnectionScopeDataSourceModel struct {
+	ID            types.String                      `tfsdk:"id"`
+	Blueprints    []scopeBlueprintDataSourceModel   `tfsdk:"blueprints"`
+	CloneUrl      types.String                      `tfsdk:"clone_url"`
+	CreatedAt     types.String                      `tfsdk:"created_at"`
+	Description   types.String                      `tfsdk:"description"`
+	FullName      types.String                      `tfsdk:"full_name"`
+	HtmlUrl       types.String                      `tfsdk:"html_url"`
+	Name          types.String                      `tfsdk:"name"`
+	ScopeConfig   *githubConnectionScopeConfigModel `tfsdk:"scope_config"`
+	ScopeConfigId types.String                      `tfsdk:"scope_config_id"`
+	UpdatedAt     types.String                      `tfsdk:"updated_at"`
+}
+
+// scopeBlueprintDataSourceModel maps a blueprint referencing a scope.
+type scopeBlueprintDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Enable      types.Bool   `tfsdk:"enable"`
+	Name        types.String `tfsdk:"name"`
+	ProjectName types.String `tfsdk:"project_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *githubConnectionScopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_github_connection_scopes"
+}
+
+// Schema defines the schema for the data source.
+func (d *githubConnectionScopesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	scopeConfigAttribute, diags := scopeConfigDataSourceAttribute(ctx, NewGithubConnectionScopeConfigResource())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the scopes of a github connection with their scope config and the blueprints referencing them, e.g. to audit the scopes in terraform checks.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Description: "The github connection to list the scopes of.",
+				Required:    true,
+			},
+			"scopes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The scopes of the connection.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The numeric github id of the repository. This is a string to match the scope_ids of blueprints.",
+						},
+						"blueprints": scopeBlueprintsDataSourceAttribute(),
+						"clone_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The https url to clone the repository.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the scope was created in devlake.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the scope.",
+						},
+						"full_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the repository including its owner, e.g. 'apache/incubator-devlake'.",
+						},
+						"html_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The url of the repository on github.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the repository.",
+						},
+						"scope_config": scopeConfigAttribute,
+						"scope_config_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the scope config attached to the scope, null if there is none.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the scope was updated in devlake.",
+						},
+					},
+				},
+			},
+			"search_term": schema.StringAttribute{
+				Description: "Only list the scopes whose name contains the search term.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// scopeBlueprintsDataSourceAttribute returns the computed blueprints
+// referencing a scope, shared by the scopes data sources of all plugins.
+func scopeBlueprintsDataSourceAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The blueprints referencing the scope.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					Description: "Numeric identifier of the blueprint.",
+				},
+				"enable": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether the blueprint is enabled.",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "The name of the blueprint.",
+				},
+				"project_name": schema.StringAttribute{
+					Computed:    true,
+					Description: "The name of the project of the blueprint.",
+				},
+			},
+		},
+	}
+}
+
+// scopeConfigDataSourceAttribute returns the computed scope config attached
+// to a scope, shared by the scopes data sources of all plugins. The attributes
+// are taken from the scope config resource of the plugin, so the data source
+// exposes the complete scope config.
+func scopeConfigDataSourceAttribute(ctx context.Context, scopeConfigResource resource.Resource) (schema.SingleNestedAttribute, diag.Diagnostics) {
+	var scopeConfigSchema resource.SchemaResponse
+	scopeConfigResource.Schema(ctx, resource.SchemaRequest{}, &scopeConfigSchema)
+	if scopeConfigSchema.Diagnostics.HasError() {
+		return schema.SingleNestedAttribute{}, scopeConfigSchema.Diagnostics
+	}
+
+	attributes := map[string]resourceschema.Attribute{}
+	for name, attribute := range scopeConfigSchema.Schema.Attributes {
+		// only known to the terraform state of the resource
+		if name != "last_updated" {
+			attributes[name] = attribute
+		}
+	}
+	scopeConfigAttributes, diags := computedDataSourceAttributes(attributes)
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "The scope config attached to the scope, null if there is none.",
+		Attributes:  scopeConfigAttributes,
+	}, diags
+}
+
+// computedDataSourceAttributes converts resource attributes to computed data
+// source attributes with the same types and descriptions.
+func computedDataSourceAttributes(attributes map[string]resourceschema.Attribute) (map[string]schema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	dataSourceAttributes := map[string]schema.Attribute{}
+	for name, attribute := range attributes {
+		switch attribute := attribute.(type) {
+		case resourceschema.BoolAttribute:
+			dataSourceAttributes[name] = schema.BoolAttribute{Computed: true, CustomType: attribute.CustomType, Description: attribute.Description}
+		case resourceschema.Int64Attribute:
+			dataSourceAttributes[name] = schema.Int64Attribute{Computed: true, CustomType: attribute.CustomType, Description: attribute.Description}
+		case resourceschema.ListAttribute:
+			dataSourceAttributes[name] = schema.ListAttribute{Computed: true, CustomType: attribute.CustomType, Description: attribute.Description, ElementType: attribute.ElementType}
+		case resourceschema.MapAttribute:
+			dataSourceAttributes[name] = schema.MapAttribute{Computed: true, CustomType: attribute.CustomType, Description: attribute.Description, ElementType: attribute.ElementType}
+		case resourceschema.SetAttribute:
+			dataSourceAttributes[name] = schema.SetAttribute{Computed: true, CustomType: attribute.CustomType, Description: attribute.Description, ElementType: attribute.ElementType}
+		case resourceschema.StringAttribute:
+			dataSourceAttributes[name] = schema.StringAttribute{Computed: true, CustomType: attribute.CustomType, Description: attribute.Description}
+		case resourceschema.SingleNestedAttribute:
+			nestedAttributes, d := computedDataSourceAttributes(attribute.Attributes)
+			diags.Append(d...)
+			dataSourceAttributes[name] = schema.SingleNestedAttribute{Computed: true, CustomType: attribute.CustomType, Description: attribute.Description, Attributes: nestedAttributes}
+		default:
+			diags.AddError(
+				"Unsupported Attribute Type",
+				fmt.Sprintf("The attribute %s of type %T can not be converted to a data source attribute. Please report this issue to the provider developers.", name, attribute),
+			)
+		}
+	}
+	return dataSourceAttributes, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *githubConnectionScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state githubConnectionScopesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	githubConnectionScopes, err := d.client.ListGithubConnectionScopeDetails(ctx, state.ConnectionId.ValueString(), state.SearchTerm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake github connection scopes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Scopes = []githubConnectionScopeDataSourceModel{}
+	for _, githubConnectionScope := range githubConnectionScopes {
+		scope := githubConnectionScope.Scope
+		scopeState := githubConnectionScopeDataSourceModel{
+			ID:            types.StringValue(strconv.Itoa(scope.GithubId)),
+			Blueprints:    scopeBlueprintsToDataSourceModel(githubConnectionScope.Blueprints),
+			CloneUrl:      types.StringValue(scope.CloneUrl),
+			CreatedAt:     types.StringValue(scope.CreatedAt),
+			Description:   types.StringValue(scope.Description),
+			FullName:      types.StringValue(scope.FullName),
+			HtmlUrl:       types.StringValue(scope.HTMLUrl),
+			Name:          types.StringValue(scope.Name),
+			ScopeConfigId: scopeConfigIdToDataSourceModel(scope.ScopeConfigId),
+			UpdatedAt:     types.StringValue(scope.UpdatedAt),
+		}
+		if scopeConfig := githubConnectionScope.ScopeConfig; scopeConfig != nil && scopeConfig.ID != 0 {
+			scopeState.ScopeConfig = &githubConnectionScopeConfigModel{}
+			resp.Diagnostics.Append(githubConnectionScopeConfigToModel(ctx, scopeConfig, scopeState.ScopeConfig)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		state.Scopes = append(state.Scopes, scopeState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// scopeBlueprintsToDataSourceModel maps the blueprints referencing a scope
+// to the data source model.
+func scopeBlueprintsToDataSourceModel(blueprints []client.Blueprint) []scopeBlueprintDataSourceModel {
+	blueprintsState := []scopeBlueprintDataSourceModel{}
+	for _, blueprint := range blueprints {
+		blueprintsState = append(blueprintsState, scopeBlueprintDataSourceModel{
+			ID:          types.StringValue(strconv.Itoa(blueprint.ID)),
+			Enable:      types.BoolValue(blueprint.Enable),
+			Name:        types.StringValue(blueprint.Name),
+			ProjectName: types.StringValue(blueprint.ProjectName),
+		})
+	}
+	return blueprintsState
+}
+
+// scopeConfigIdToDataSourceModel maps the scope config id of a scope, devlake
+// uses 0 for scopes without a scope config.
+func scopeConfigIdToDataSourceModel(scopeConfigId int) types.String {
+	if scopeConfigId == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(strconv.Itoa(scopeConfigId))
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *githubConnectionScopesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
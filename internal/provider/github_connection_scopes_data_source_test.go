@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubConnectionScopesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the scope first, data sources are read before
+			// dependent resources are created otherwise.
+			{
+				Config: githubConnectionScopeConfig,
+			},
+			// Read testing
+			{
+				Config: githubConnectionScopeConfig + `
+data "devlake_github_connection_scopes" "test" {
+  connection_id = devlake_github_connection.gh.id
+  search_term   = "REPO"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devlake_github_connection_scopes.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection_scopes.test", "scopes.0.id", "42"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection_scopes.test", "scopes.0.full_name", "PROJECT/REPO"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection_scopes.test", "scopes.0.blueprints.#", "0"),
+					resource.TestCheckResourceAttrPair("data.devlake_github_connection_scopes.test", "scopes.0.scope_config_id", "devlake_github_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttrPair("data.devlake_github_connection_scopes.test", "scopes.0.scope_config.id", "devlake_github_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttr("data.devlake_github_connection_scopes.test", "scopes.0.scope_config.name", "conf1"),
+					resource.TestCheckResourceAttrPair("data.devlake_github_connection_scopes.test", "scopes.0.scope_config.deployment_pattern", "devlake_github_connection_scopeconfig.scopeconf", "deployment_pattern"),
+					resource.TestCheckResourceAttrPair("data.devlake_github_connection_scopes.test", "scopes.0.scope_config.production_pattern", "devlake_github_connection_scopeconfig.scopeconf", "production_pattern"),
+					resource.TestCheckResourceAttrPair("data.devlake_github_connection_scopes.test", "scopes.0.scope_config.pr_type", "devlake_github_connection_scopeconfig.scopeconf", "pr_type"),
+					resource.TestCheckResourceAttrPair("data.devlake_github_connection_scopes.test", "scopes.0.scope_config.ref_diff.tags_pattern", "devlake_github_connection_scopeconfig.scopeconf", "ref_diff.tags_pattern"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &githubConnectionScopesResource{}
+	_ resource.ResourceWithConfigure   = &githubConnectionScopesResource{}
+	_ resource.ResourceWithImportState = &githubConnectionScopesResource{}
+)
+
+// NewGithubConnectionScopesResource is a helper function to simplify the provider implementation.
+func NewGithubConnectionScopesResource() resource.Resource {
+	return &githubConnectionScopesResource{}
+}
+
+// githubConnectionScopesResource is the resource implementation.
+type githubConnectionScopesResource struct {
+	client *client.Client
+}
+
+// githubConnectionScopesResourceModel maps the resource schema data.
+type githubConnectionScopesResourceModel struct {
+	ID            types.String                            `tfsdk:"id"`
+	LastUpdated   types.String                            `tfsdk:"last_updated"`
+	ConnectionId  types.String                            `tfsdk:"connection_id"`
+	Repositories  []githubConnectionScopesRepositoryModel `tfsdk:"repositories"`
+	ScopeConfigId types.String                            `tfsdk:"scope_config_id"`
+	Timeouts      timeouts.Value                          `tfsdk:"timeouts"`
+}
+
+// githubConnectionScopesRepositoryModel maps the repository schema data.
+type githubConnectionScopesRepositoryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	FullName    types.String `tfsdk:"full_name"`
+}
+
+// Metadata returns the resource type name.
+func (r *githubConnectionScopesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_github_connection_scopes"
+}
+
+// Schema defines the schema for the resource.
+func (r *githubConnectionScopesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages many repositories of a github connection with a shared scope config, they are created in batches. Scopes of the connection which are not part of the repositories are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the connection, used for resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scopes.",
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection the scopes are part of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"repositories": schema.SetNestedAttribute{
+				Description: "The repositories to add as scopes, e.g. from the devlake_github_remote_scopes data source.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the repository in github.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be the numeric github id"),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "A description for the connection scope.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"full_name": schema.StringAttribute{
+							Description: "The Github org and repository in the format '<ORG>/<REPOSITORY>'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]+/[^/]+$`), "must be in the format '<ORG>/<REPOSITORY>'"),
+							},
+						},
+					},
+				},
+				Required: true,
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for all scopes. Needs to be created first.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *githubConnectionScopesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan githubConnectionScopesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	githubConnectionScopesCreate, err := r.scopesFromModel(ctx, plan, plan.Repositories)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake github connection scopes",
+			"Could not create devlake github connection scopes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Create new github connection scopes
+	_, err = r.client.CreateGithubConnectionScopes(ctx, plan.ConnectionId.ValueString(), githubConnectionScopesCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake github connection scopes",
+			"Could not create devlake github connection scopes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values
+	plan.ID = plan.ConnectionId
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *githubConnectionScopesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state githubConnectionScopesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed github connection scopes from Devlake
+	githubConnectionScopes, err := r.client.ListGithubConnectionScopes(ctx, state.ConnectionId.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake github connection scopes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite the managed repositories with refreshed state, all scopes of
+	// the connection are managed after an import.
+	imported := state.Repositories == nil
+	managed := map[string]bool{}
+	for _, repository := range state.Repositories {
+		managed[repository.ID.ValueString()] = true
+	}
+	state.Repositories = []githubConnectionScopesRepositoryModel{}
+	scopeConfigId := state.ScopeConfigId
+	for _, githubConnectionScope := range githubConnectionScopes {
+		id := strconv.Itoa(githubConnectionScope.GithubId)
+		if !imported && !managed[id] {
+			continue
+		}
+		state.Repositories = append(state.Repositories, githubConnectionScopesRepositoryModel{
+			ID:          types.StringValue(id),
+			Description: optionalStringToModel(githubConnectionScope.Description),
+			FullName:    types.StringValue(githubConnectionScope.FullName),
+		})
+		// a scope with another scope config shows up as change
+		if id := strconv.Itoa(githubConnectionScope.ScopeConfigId); id != state.ScopeConfigId.ValueString() {
+			scopeConfigId = types.StringValue(id)
+		}
+	}
+	state.ID = state.ConnectionId
+	state.ScopeConfigId = scopeConfigId
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update adds, updates and removes scopes according to the difference of
+// the planned and the current repositories.
+func (r *githubConnectionScopesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state githubConnectionScopesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Changed and added repositories are created again, which updates them.
+	current := map[string]githubConnectionScopesRepositoryModel{}
+	for _, repository := range state.Repositories {
+		current[repository.ID.ValueString()] = repository
+	}
+	scopeConfigChanged := !plan.ScopeConfigId.Equal(state.ScopeConfigId)
+	changed := []githubConnectionScopesRepositoryModel{}
+	for _, repository := range plan.Repositories {
+		if currentRepository, ok := current[repository.ID.ValueString()]; !ok || scopeConfigChanged || currentRepository != repository {
+			changed = append(changed, repository)
+		}
+		delete(current, repository.ID.ValueString())
+	}
+
+	if len(changed) > 0 {
+		githubConnectionScopesUpdate, err := r.scopesFromModel(ctx, plan, changed)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating devlake github connection scopes",
+				"Could not update devlake github connection scopes, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		_, err = r.client.CreateGithubConnectionScopes(ctx, plan.ConnectionId.ValueString(), githubConnectionScopesUpdate)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating devlake github connection scopes",
+				"Could not update devlake github connection scopes, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// The remaining current repositories were removed from the plan.
+	for id := range current {
+		err := r.client.DeleteGithubConnectionScope(ctx, plan.ConnectionId.ValueString(), id)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error updating devlake github connection scopes",
+				"Could not delete devlake github connection scope "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = plan.ConnectionId
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *githubConnectionScopesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state githubConnectionScopesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing scopes
+	for _, repository := range state.Repositories {
+		err := r.client.DeleteGithubConnectionScope(ctx, state.ConnectionId.ValueString(), repository.ID.ValueString())
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting devlake github connection scopes",
+				"Could not delete devlake github connection scope "+repository.ID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *githubConnectionScopesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and connection id attribute, all
+	// scopes of the connection are imported.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// scopesFromModel generates the API request body for the given repositories,
+// the urls are derived from the endpoint of the connection.
+func (r *githubConnectionScopesResource) scopesFromModel(ctx context.Context, plan githubConnectionScopesResourceModel, repositories []githubConnectionScopesRepositoryModel) ([]client.GithubConnectionScope, error) {
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	connection, err := r.client.ReadGithubConnection(ctx, plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	webUrl := githubWebUrl(connection.Endpoint)
+
+	now := time.Now().Format(time.RFC3339)
+	scopes := []client.GithubConnectionScope{}
+	for _, repository := range repositories {
+		id, err := strconv.Atoi(repository.ID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		fullName := repository.FullName.ValueString()
+		_, name, _ := strings.Cut(fullName, "/")
+		scopes = append(scopes, client.GithubConnectionScope{
+			GithubId:      id,
+			CloneUrl:      webUrl + fullName + ".git",
+			ConnectionId:  connectionId,
+			CreatedAt:     now,
+			Description:   repository.Description.ValueString(),
+			FullName:      fullName,
+			HTMLUrl:       webUrl + fullName,
+			Name:          name,
+			ScopeConfigId: scopeConfigId,
+			UpdatedAt:     now,
+			CreatedDate:   now,
+			UpdatedDate:   now,
+		})
+	}
+	return scopes, nil
+}
+
+// optionalStringToModel maps an empty string returned by devlake to null.
+func optionalStringToModel(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *githubConnectionScopesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	githubConnectionScopesConfig = githubConnectionScopeConfigConfig + `
+resource "devlake_github_connection_scopes" "scopes" {
+  connection_id	= devlake_github_connection.gh.id
+  repositories = [
+    {
+      id = "42"
+      full_name = "PROJECT/REPO"
+      description = "example repo"
+    },
+    {
+      id = "43"
+      full_name = "PROJECT/REPO2"
+    },
+  ]
+  scope_config_id = devlake_github_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccGithubConnectionScopesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: githubConnectionScopesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_github_connection_scopes.scopes", "repositories.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_github_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":          "42",
+						"full_name":   "PROJECT/REPO",
+						"description": "example repo",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_github_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":        "43",
+						"full_name": "PROJECT/REPO2",
+					}),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_github_connection_scopes.scopes", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_github_connection_scopes.scopes", "id"),
+					resource.TestCheckResourceAttrSet("devlake_github_connection_scopes.scopes", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_github_connection_scopes.scopes", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devlake_github_connection_scopes.scopes",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: githubConnectionScopeConfigConfig + `
+resource "devlake_github_connection_scopes" "scopes" {
+  connection_id	= devlake_github_connection.gh.id
+  repositories = [
+    {
+      id = "42"
+      full_name = "PROJECT/REPO"
+      description = "example repo desc"
+    },
+    {
+      id = "44"
+      full_name = "PROJECT/REPO3"
+    },
+  ]
+  scope_config_id = devlake_github_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_github_connection_scopes.scopes", "repositories.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_github_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":          "42",
+						"full_name":   "PROJECT/REPO",
+						"description": "example repo desc",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_github_connection_scopes.scopes", "repositories.*", map[string]string{
+						"id":        "44",
+						"full_name": "PROJECT/REPO3",
+					}),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_github_connection_scopes.scopes", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_github_connection_scopes.scopes", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_github_connection_scopes.scopes", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
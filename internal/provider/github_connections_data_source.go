@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &githubConnectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &githubConnectionsDataSource{}
+)
+
+// NewGithubConnectionsDataSource is a helper function to simplify the provider implementation.
+func NewGithubConnectionsDataSource() datasource.DataSource {
+	return &githubConnectionsDataSource{}
+}
+
+// githubConnectionsDataSource is the data source implementation.
+type githubConnectionsDataSource struct {
+	client *client.Client
+}
+
+// githubConnectionsDataSourceModel maps the data source schema data.
+type githubConnectionsDataSourceModel struct {
+	Connections []githubConnectionDataSourceModel `tfsdk:"connections"`
+}
+
+// Metadata returns the data source type name.
+func (d *githubConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_github_connections"
+}
+
+// Schema defines the schema for the data source.
+func (d *githubConnectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all github connections. The credentials of the connections are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"connections": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: githubConnectionDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *githubConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := githubConnectionsDataSourceModel{
+		Connections: []githubConnectionDataSourceModel{},
+	}
+
+	githubConnections, err := d.client.ListGithubConnections(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake github connections",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	for _, githubConnection := range githubConnections {
+		connectionState, err := githubConnectionToDataSourceModel(&githubConnection)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read devlake github connections",
+				"Could not parse app id of devlake github connection "+githubConnection.Name+": "+err.Error(),
+			)
+			return
+		}
+
+		state.Connections = append(state.Connections, connectionState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *githubConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
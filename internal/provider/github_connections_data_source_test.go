@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubConnectionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the connection first, data sources are read before
+			// dependent resources are created otherwise.
+			{
+				Config: githubConnectionConfig,
+			},
+			// Read testing
+			{
+				Config: githubConnectionConfig + `data "devlake_github_connections" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devlake_github_connections.test", "connections.#", "1"),
+					resource.TestCheckResourceAttrPair("data.devlake_github_connections.test", "connections.0.id", "devlake_github_connection.gh", "id"),
+					resource.TestCheckResourceAttr("data.devlake_github_connections.test", "connections.0.name", "should_not_exist"),
+					resource.TestCheckResourceAttr("data.devlake_github_connections.test", "connections.0.app_id", "123123"),
+					resource.TestCheckNoResourceAttr("data.devlake_github_connections.test", "connections.0.secret_key"),
+				),
+			},
+		},
+	})
+}
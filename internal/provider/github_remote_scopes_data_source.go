@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &githubRemoteScopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &githubRemoteScopesDataSource{}
+)
+
+// NewGithubRemoteScopesDataSource is a helper function to simplify the provider implementation.
+func NewGithubRemoteScopesDataSource() datasource.DataSource {
+	return &githubRemoteScopesDataSource{}
+}
+
+// githubRemoteScopesDataSource is the data source implementation.
+type githubRemoteScopesDataSource struct {
+	client *client.Client
+}
+
+// githubRemoteScopesDataSourceModel maps the data source schema data.
+type githubRemoteScopesDataSourceModel struct {
+	ConnectionId types.String             `tfsdk:"connection_id"`
+	Owner        types.String             `tfsdk:"owner"`
+	Scopes       []githubRemoteScopeModel `tfsdk:"scopes"`
+	Search       types.String             `tfsdk:"search"`
+}
+
+// githubRemoteScopeModel maps the repository schema data.
+type githubRemoteScopeModel struct {
+	CloneUrl    types.String `tfsdk:"clone_url"`
+	Description types.String `tfsdk:"description"`
+	FullName    types.String `tfsdk:"full_name"`
+	GithubId    types.Int64  `tfsdk:"github_id"`
+	HtmlUrl     types.String `tfsdk:"html_url"`
+	Name        types.String `tfsdk:"name"`
+}
+
+// Metadata returns the data source type name.
+func (d *githubRemoteScopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_github_remote_scopes"
+}
+
+// Schema defines the schema for the data source.
+func (d *githubRemoteScopesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the github repositories a connection has access to, either all repositories of an organization or user, or the ones matching a search. The results can be used to create devlake_github_connection_scope resources.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Description: "The github connection used to list the repositories.",
+				Required:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "The login of the organization or user to list all repositories of. Exactly one of owner and search must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("search")),
+				},
+			},
+			"scopes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching repositories.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"clone_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The https url to clone the repository.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the repository.",
+						},
+						"full_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the repository including its owner, e.g. 'apache/incubator-devlake'.",
+						},
+						"github_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The numeric github id of the repository, used as id of the devlake_github_connection_scope.",
+						},
+						"html_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The url of the repository on github.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the repository.",
+						},
+					},
+				},
+			},
+			"search": schema.StringAttribute{
+				Description: "Search term the repository names are matched against, using the github search. Exactly one of owner and search must be set.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *githubRemoteScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state githubRemoteScopesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var githubRemoteScopes []client.GithubConnectionScope
+	var err error
+	if !state.Owner.IsNull() {
+		githubRemoteScopes, err = d.client.ListGithubRemoteScopes(ctx, state.ConnectionId.ValueString(), state.Owner.ValueString())
+	} else {
+		githubRemoteScopes, err = d.client.SearchGithubRemoteScopes(ctx, state.ConnectionId.ValueString(), state.Search.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake github remote scopes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Scopes = []githubRemoteScopeModel{}
+	for _, githubRemoteScope := range githubRemoteScopes {
+		state.Scopes = append(state.Scopes, githubRemoteScopeModel{
+			CloneUrl:    types.StringValue(githubRemoteScope.CloneUrl),
+			Description: types.StringValue(githubRemoteScope.Description),
+			FullName:    types.StringValue(githubRemoteScope.FullName),
+			GithubId:    types.Int64Value(int64(githubRemoteScope.GithubId)),
+			HtmlUrl:     types.StringValue(githubRemoteScope.HTMLUrl),
+			Name:        types.StringValue(githubRemoteScope.Name),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *githubRemoteScopesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubRemoteScopesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing, listing remote scopes requires valid
+			// github credentials.
+			{
+				Config: githubConnectionConfig + `
+data "devlake_github_remote_scopes" "test" {
+  connection_id = devlake_github_connection.gh.id
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: githubConnectionConfig + `
+data "devlake_github_remote_scopes" "test" {
+  connection_id = devlake_github_connection.gh.id
+  owner         = "apache"
+  search        = "devlake"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
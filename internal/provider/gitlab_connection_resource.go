@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabConnectionResource{}
+	_ resource.ResourceWithConfigure   = &gitlabConnectionResource{}
+	_ resource.ResourceWithImportState = &gitlabConnectionResource{}
+)
+
+// NewGitlabConnectionResource is a helper function to simplify the provider implementation.
+func NewGitlabConnectionResource() resource.Resource {
+	return &gitlabConnectionResource{}
+}
+
+// gitlabConnectionResource is the resource implementation.
+type gitlabConnectionResource struct {
+	client *client.Client
+}
+
+// gitlabConnectionResourceModel maps the resource schema data.
+type gitlabConnectionResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Endpoint         types.String   `tfsdk:"endpoint"`
+	Name             types.String   `tfsdk:"name"`
+	Proxy            types.String   `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64    `tfsdk:"rate_limit_per_hour"`
+	Token            types.String   `tfsdk:"token"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *gitlabConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitlab_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *gitlabConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was created in devlake.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("https://gitlab.com/api/v4/"),
+				Description: "The base endpoint URL, use the api url of your instance for self-hosted gitlab. Defaults to 'https://gitlab.com/api/v4/'.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the gitlab connection.",
+				Required:    true,
+			},
+			"proxy": schema.StringAttribute{
+				Computed:    true,
+				Description: "If you are behind a corporate firewall or VPN you may need to utilize a proxy server.",
+				Optional:    true,
+			},
+			"rate_limit_per_hour": schema.Int64Attribute{
+				Optional:    true,
+				Description: "DevLake uses a dynamic rate limit to collect GitLab data. You can adjust the rate limit if you want to increase or lower the speed.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Personal access token used for authentication, the following scopes are required to collect data from GitLab projects: read_api, read_repository.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was updated in devlake.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *gitlabConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan gitlabConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	now := time.Now().Format(time.RFC850)
+
+	// Generate API request body from plan
+	var gitlabConnectionCreate = client.GitlabConnection{
+		CreatedAt:        now,
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		Token:            plan.Token.ValueString(),
+		UpdatedAt:        now,
+	}
+
+	// Create new gitlabconnection
+	gitlabConnection, err := r.client.CreateGitlabConnection(ctx, gitlabConnectionCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection",
+			"Could not create devlake gitlab connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(gitlabConnection.ID))
+	plan.LastUpdated = types.StringValue(now)
+	plan.CreatedAt = types.StringValue(gitlabConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(gitlabConnection.Endpoint)
+	plan.Name = types.StringValue(gitlabConnection.Name)
+	plan.Proxy = types.StringValue(gitlabConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(gitlabConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(gitlabConnection.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state gitlabConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed gitlab connection value from Devlake
+	gitlabConnection, err := r.client.ReadGitlabConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake gitlab connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(gitlabConnection.ID))
+	state.CreatedAt = types.StringValue(gitlabConnection.CreatedAt)
+	state.Endpoint = types.StringValue(gitlabConnection.Endpoint)
+	state.Name = types.StringValue(gitlabConnection.Name)
+	state.Proxy = types.StringValue(gitlabConnection.Proxy)
+	state.RateLimitPerHour = types.Int64Value(int64(gitlabConnection.RateLimitPerHour))
+	state.UpdatedAt = types.StringValue(gitlabConnection.UpdatedAt)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *gitlabConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan gitlabConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection",
+			"Could not update devlake gitlab connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var gitlabConnectionUpdate = client.GitlabConnection{
+		ID:               id,
+		CreatedAt:        plan.CreatedAt.ValueString(),
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		Token:            plan.Token.ValueString(),
+		UpdatedAt:        time.Now().Format(time.RFC850),
+	}
+
+	// Update existing connection
+	updatedGitlabConnection, err := r.client.UpdateGitlabConnection(ctx, plan.ID.ValueString(), gitlabConnectionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection",
+			"Could not update devlake gitlab connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(updatedGitlabConnection.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.StringValue(updatedGitlabConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(updatedGitlabConnection.Endpoint)
+	plan.Name = types.StringValue(updatedGitlabConnection.Name)
+	plan.Proxy = types.StringValue(updatedGitlabConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(updatedGitlabConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(updatedGitlabConnection.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *gitlabConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state gitlabConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing apikey
+	err := r.client.DeleteGitlabConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake gitlab connection",
+			"Could not delete devlake gitlab connection, unexpected error: "+err.Error()+"..",
+		)
+		return
+	}
+}
+
+func (r *gitlabConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
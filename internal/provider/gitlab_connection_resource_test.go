@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	gitlabConnectionConfig = providerConfig + `
+resource "devlake_gitlab_connection" "gl" {
+  endpoint  = "https://gitlab.example.org/api/v4/"
+  name      = "should_not_exist"
+  token     = "glpat-whatever"
+}
+`
+)
+
+func TestAccGitlabConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: gitlabConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "endpoint", "https://gitlab.example.org/api/v4/"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "token", "glpat-whatever"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "id"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_gitlab_connection.gl",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_gitlab_connection.gl"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_gitlab_connection.gl not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"token", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_gitlab_connection" "gl" {
+  endpoint            = "https://gitlab.example.org/api/v4/"
+  name                = "should_not_exist"
+  rate_limit_per_hour = 1000
+  token               = "glpat-whatever"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "endpoint", "https://gitlab.example.org/api/v4/"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "rate_limit_per_hour", "1000"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection.gl", "token", "glpat-whatever"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "id"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection.gl", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabConnectionScopeResource{}
+	_ resource.ResourceWithConfigure   = &gitlabConnectionScopeResource{}
+	_ resource.ResourceWithImportState = &gitlabConnectionScopeResource{}
+)
+
+// NewGitlabConnectionScopeResource is a helper function to simplify the provider implementation.
+func NewGitlabConnectionScopeResource() resource.Resource {
+	return &gitlabConnectionScopeResource{}
+}
+
+// gitlabConnectionScopeResource is the resource implementation.
+type gitlabConnectionScopeResource struct {
+	client *client.Client
+}
+
+// gitlabConnectionScopeResourceModel maps the resource schema data.
+type gitlabConnectionScopeResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+	ConnectionId      types.String   `tfsdk:"connection_id"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	Description       types.String   `tfsdk:"description"`
+	HttpUrlToRepo     types.String   `tfsdk:"http_url_to_repo"`
+	PathWithNamespace types.String   `tfsdk:"path_with_namespace"`
+	ScopeConfigId     types.String   `tfsdk:"scope_config_id"`
+	WebUrl            types.String   `tfsdk:"web_url"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *gitlabConnectionScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitlab_connection_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *gitlabConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The numeric id of the project in gitlab.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection this scope is part of.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A description for the connection scope.",
+				Optional:    true,
+			},
+			"http_url_to_repo": schema.StringAttribute{
+				Description: "The Gitlab https clone url of the project.",
+				Required:    true,
+			},
+			"path_with_namespace": schema.StringAttribute{
+				Description: "The Gitlab group and project in the format '<GROUP>/<PROJECT>', groups may be nested.",
+				Required:    true,
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for the scope. Needs to be created first.",
+				Required:    true,
+			},
+			"web_url": schema.StringAttribute{
+				Description: "The Gitlab web url of the project.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *gitlabConnectionScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan gitlabConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection scope",
+			"Could not create devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection scope",
+			"Could not create devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection scope",
+			"Could not create devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC3339)
+	var gitlabConnectionScopeCreate = client.GitlabConnectionScope{
+		ConnectionId:      connectionId,
+		CreatedAt:         now,
+		Description:       plan.Description.ValueString(),
+		GitlabId:          id,
+		HttpUrlToRepo:     plan.HttpUrlToRepo.ValueString(),
+		Name:              gitlabProjectName(plan.PathWithNamespace.ValueString()),
+		PathWithNamespace: plan.PathWithNamespace.ValueString(),
+		ScopeConfigId:     scopeConfigId,
+		UpdatedAt:         now,
+		WebUrl:            plan.WebUrl.ValueString(),
+	}
+
+	// Create new gitlabconnectionscope
+	gitlabConnectionScope, err := r.client.CreateGitlabConnectionScope(ctx, plan.ConnectionId.ValueString(), gitlabConnectionScopeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection scope",
+			"Could not create devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(gitlabConnectionScope.GitlabId))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	plan.ConnectionId = types.StringValue(strconv.Itoa(gitlabConnectionScope.ConnectionId))
+	plan.CreatedAt = types.StringValue(gitlabConnectionScope.CreatedAt)
+	plan.Description = types.StringValue(gitlabConnectionScope.Description)
+	plan.HttpUrlToRepo = types.StringValue(gitlabConnectionScope.HttpUrlToRepo)
+	plan.PathWithNamespace = types.StringValue(gitlabConnectionScope.PathWithNamespace)
+	plan.ScopeConfigId = types.StringValue(strconv.Itoa(gitlabConnectionScope.ScopeConfigId))
+	plan.WebUrl = types.StringValue(gitlabConnectionScope.WebUrl)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabConnectionScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state gitlabConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed gitlab connection scope value from Devlake
+	gitlabConnectionScope, err := r.client.ReadGitlabConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake gitlab connection scope",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(gitlabConnectionScope.GitlabId))
+	state.ConnectionId = types.StringValue(strconv.Itoa(gitlabConnectionScope.ConnectionId))
+	state.CreatedAt = types.StringValue(gitlabConnectionScope.CreatedAt)
+	state.Description = types.StringValue(gitlabConnectionScope.Description)
+	state.HttpUrlToRepo = types.StringValue(gitlabConnectionScope.HttpUrlToRepo)
+	state.PathWithNamespace = types.StringValue(gitlabConnectionScope.PathWithNamespace)
+	state.ScopeConfigId = types.StringValue(strconv.Itoa(gitlabConnectionScope.ScopeConfigId))
+	state.WebUrl = types.StringValue(gitlabConnectionScope.WebUrl)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *gitlabConnectionScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan gitlabConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection scope",
+			"Could not update devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection scope",
+			"Could not update devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection scope",
+			"Could not create devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var gitlabConnectionScopeUpdate = client.GitlabConnectionScope{
+		ConnectionId:      connectionId,
+		CreatedAt:         plan.CreatedAt.ValueString(),
+		Description:       plan.Description.ValueString(),
+		GitlabId:          id,
+		HttpUrlToRepo:     plan.HttpUrlToRepo.ValueString(),
+		Name:              gitlabProjectName(plan.PathWithNamespace.ValueString()),
+		PathWithNamespace: plan.PathWithNamespace.ValueString(),
+		ScopeConfigId:     scopeConfigId,
+		UpdatedAt:         time.Now().Format(time.RFC3339),
+		WebUrl:            plan.WebUrl.ValueString(),
+	}
+
+	// Update existing connection scope
+	updatedGitlabConnectionScope, err := r.client.UpdateGitlabConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), gitlabConnectionScopeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection scope",
+			"Could not update devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(strconv.Itoa(updatedGitlabConnectionScope.GitlabId))
+	plan.ConnectionId = types.StringValue(strconv.Itoa(updatedGitlabConnectionScope.ConnectionId))
+	plan.CreatedAt = types.StringValue(updatedGitlabConnectionScope.CreatedAt)
+	plan.Description = types.StringValue(updatedGitlabConnectionScope.Description)
+	plan.HttpUrlToRepo = types.StringValue(updatedGitlabConnectionScope.HttpUrlToRepo)
+	plan.PathWithNamespace = types.StringValue(updatedGitlabConnectionScope.PathWithNamespace)
+	plan.ScopeConfigId = types.StringValue(strconv.Itoa(updatedGitlabConnectionScope.ScopeConfigId))
+	plan.WebUrl = types.StringValue(updatedGitlabConnectionScope.WebUrl)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *gitlabConnectionScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state gitlabConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing apikey
+	err := r.client.DeleteGitlabConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake gitlab connection scope",
+			"Could not delete devlake gitlab connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *gitlabConnectionScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scope_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabConnectionScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// gitlabProjectName returns the name of the project, which is the last
+// segment of the path including its (nested) groups.
+func gitlabProjectName(pathWithNamespace string) string {
+	return pathWithNamespace[strings.LastIndex(pathWithNamespace, "/")+1:]
+}
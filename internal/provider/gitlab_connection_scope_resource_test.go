@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	gitlabConnectionScopeConfig = gitlabConnectionScopeConfigConfig + `
+resource "devlake_gitlab_connection_scope" "scope" {
+  id = "42"
+  http_url_to_repo = "https://gitlab.example.org/group/repo.git"
+  path_with_namespace = "group/repo"
+  web_url = "https://gitlab.example.org/group/repo"
+  connection_id	= devlake_gitlab_connection.gl.id
+  description = "example repo"
+  scope_config_id = devlake_gitlab_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccGitlabConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: gitlabConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_gitlab_connection_scope.scope", "description", "example repo"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection_scope.scope", "path_with_namespace", "group/repo"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection_scope.scope", "id", "42"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_gitlab_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_gitlab_connection.gl"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_gitlab_connection.gl not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_gitlab_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_gitlab_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "scope_config_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: gitlabConnectionScopeConfigConfig + `
+resource "devlake_gitlab_connection_scope" "scope" {
+  id = "42"
+  http_url_to_repo = "https://gitlab.example.org/group/repo.git"
+  path_with_namespace = "group/repo"
+  web_url = "https://gitlab.example.org/group/repo"
+  connection_id	= devlake_gitlab_connection.gl.id
+  description = "example repo desc"
+  scope_config_id = devlake_gitlab_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_gitlab_connection_scope.scope", "description", "example repo desc"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection_scope.scope", "path_with_namespace", "group/repo"),
+					resource.TestCheckResourceAttr("devlake_gitlab_connection_scope.scope", "id", "42"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_gitlab_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
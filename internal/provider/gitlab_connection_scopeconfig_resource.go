@@ -0,0 +1,589 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabConnectionScopeConfigResource{}
+	_ resource.ResourceWithConfigure   = &gitlabConnectionScopeConfigResource{}
+	_ resource.ResourceWithImportState = &gitlabConnectionScopeConfigResource{}
+)
+
+// NewGitlabConnectionScopeConfigResource is a helper function to simplify the provider implementation.
+func NewGitlabConnectionScopeConfigResource() resource.Resource {
+	return &gitlabConnectionScopeConfigResource{}
+}
+
+// gitlabConnectionScopeConfigResource is the resource implementation.
+type gitlabConnectionScopeConfigResource struct {
+	client *client.Client
+}
+
+// gitlabConnectionScopeConfigResourceModel maps the resource schema data.
+type gitlabConnectionScopeConfigResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	LastUpdated          types.String   `tfsdk:"last_updated"`
+	ConnectionId         types.String   `tfsdk:"connection_id"`
+	CreatedAt            types.String   `tfsdk:"created_at"`
+	DeploymentPattern    types.String   `tfsdk:"deployment_pattern"`
+	Entities             types.List     `tfsdk:"entities"`
+	EnvNamePattern       types.String   `tfsdk:"env_name_pattern"`
+	IssueComponent       types.String   `tfsdk:"issue_component"`
+	IssuePriority        types.String   `tfsdk:"issue_priority"`
+	IssueSeverity        types.String   `tfsdk:"issue_severity"`
+	IssueTypeBug         types.String   `tfsdk:"issue_type_bug"`
+	IssueTypeIncident    types.String   `tfsdk:"issue_type_incident"`
+	IssueTypeRequirement types.String   `tfsdk:"issue_type_requirement"`
+	Name                 types.String   `tfsdk:"name"`
+	PrBodyClosePattern   types.String   `tfsdk:"pr_body_close_pattern"`
+	PrComponent          types.String   `tfsdk:"pr_component"`
+	PrType               types.String   `tfsdk:"pr_type"`
+	ProductionPattern    types.String   `tfsdk:"production_pattern"`
+	RefDiff              *refDiff       `tfsdk:"ref_diff"`
+	UpdatedAt            types.String   `tfsdk:"updated_at"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *gitlabConnectionScopeConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitlab_connection_scopeconfig"
+}
+
+// Schema defines the schema for the resource.
+func (r *gitlabConnectionScopeConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection scopeconfig. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the scope config.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The connection id of the connection this scope config belongs to.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope config was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Convert a GitLab pipeline as a DevLake Deployment when: The name of one of the jobs of the GitLab pipeline matches this pattern.",
+				Optional:    true,
+			},
+			"entities": schema.ListAttribute{
+				Computed:    true,
+				Description: "The entities this scope config uses, e.g. 'CODEREVIEW', 'CROSS' or 'CODE'. See the documentation for the meaning of the individual values.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Default: listdefault.StaticValue(types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("CODE"),
+						types.StringValue("CODEREVIEW"),
+						types.StringValue("CROSS"),
+						types.StringValue("CICD"),
+					},
+				)),
+			},
+			"env_name_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "If its environment name matches this pattern, this deployment is a 'Production Deployment'.",
+				Optional:    true,
+			},
+			"issue_component": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("component(.*)"),
+				Description: "Issue labels that match the RegEx will be set as the component of the issue.",
+				Optional:    true,
+			},
+			"issue_priority": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(highest|high|medium|low|p0|p1|p2|p3)"),
+				Description: "Issue labels that match the RegEx will be set as the priority of the issue.",
+				Optional:    true,
+			},
+			"issue_severity": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("severity(.*)"),
+				Description: "Issue labels that match the RegEx will be set as the severity of the issue.",
+				Optional:    true,
+			},
+			"issue_type_bug": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(bug|broken)"),
+				Description: "Issues with a label that matches the RegEx will be set as type 'BUG'.",
+				Optional:    true,
+			},
+			"issue_type_incident": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(incident|failure)"),
+				Description: "Issues with a label that matches the RegEx will be set as type 'INCIDENT'.",
+				Optional:    true,
+			},
+			"issue_type_requirement": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("(feat|feature|proposal|requirement)"),
+				Description: "Issues with a label that matches the RegEx will be set as type 'REQUIREMENT'.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the scope config.",
+				Required:    true,
+			},
+			"pr_body_close_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Connect entities across domains to measure metrics such as Bug Count per 1k Lines of Code. Connect merge requests and Issues with the following pattern.",
+				Optional:    true,
+			},
+			"pr_component": schema.StringAttribute{
+				Computed:    true,
+				Description: "Text (merge request description) that matches the RegEx will be set as the component of the merge request.",
+				Optional:    true,
+			},
+			"pr_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Text (merge request title) that matches the RegEx will be set as the type of a merge request.",
+				Optional:    true,
+			},
+			"production_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Convert a GitLab pipeline as a DevLake Deployment when: If the name of the job or the pipeline’s branch name also matches this pattern, this deployment is a 'Production Deployment'. Use only with 'deployment_pattern'.",
+				Optional:    true,
+			},
+			"ref_diff": schema.SingleNestedAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Calculate the commits diff between two consecutive tags that match the following RegEx. Issues closed by PRs which contain these commits will also be calculated. The result will be shown in table.refs_commits_diffs and table.refs_issues_diffs.",
+				Default: objectdefault.StaticValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"tags_limit":   types.Int64Type,
+						"tags_pattern": types.StringType,
+					},
+					map[string]attr.Value{
+						"tags_limit":   types.Int64Value(10),
+						"tags_pattern": types.StringValue(`/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+					},
+				)),
+				Attributes: map[string]schema.Attribute{
+					"tags_limit": schema.Int64Attribute{
+						Computed:    true,
+						Default:     int64default.StaticInt64(10),
+						Description: "Compare the last number of tags.",
+						Optional:    true,
+					},
+					"tags_pattern": schema.StringAttribute{
+						Computed:    true,
+						Default:     stringdefault.StaticString(`/v\d+\.\d+(\.\d+(-rc)*\d*)*$/`),
+						Description: "Matching tags are included in the calculation.",
+						Optional:    true,
+					},
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was updated in devlake.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *gitlabConnectionScopeConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan gitlabConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection scopeconfig",
+			"Could not create devlake gitlab connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC850)
+	var gitlabConnectionScopeConfigCreate = client.GitlabConnectionScopeConfig{
+		ConnectionId:         connectionId,
+		CreatedAt:            now,
+		DeploymentPattern:    plan.DeploymentPattern.ValueString(),
+		Entities:             entities,
+		EnvNamePattern:       plan.EnvNamePattern.ValueString(),
+		IssueComponent:       plan.IssueComponent.ValueString(),
+		IssuePriority:        plan.IssuePriority.ValueString(),
+		IssueSeverity:        plan.IssueSeverity.ValueString(),
+		IssueTypeBug:         plan.IssueTypeBug.ValueString(),
+		IssueTypeIncident:    plan.IssueTypeIncident.ValueString(),
+		IssueTypeRequirement: plan.IssueTypeRequirement.ValueString(),
+		Name:                 plan.Name.ValueString(),
+		PrBodyClosePattern:   plan.PrBodyClosePattern.ValueString(),
+		PrComponent:          plan.PrComponent.ValueString(),
+		PrType:               plan.PrType.ValueString(),
+		ProductionPattern:    plan.ProductionPattern.ValueString(),
+		RefDiff: &client.RefDiff{
+			TagsLimit:   int(plan.RefDiff.TagsLimit.ValueInt64()),
+			TagsPattern: plan.RefDiff.TagsPattern.ValueString(),
+		},
+		UpdatedAt: now,
+	}
+
+	// Create new gitlabconnectionscopeconfig
+	gitlabConnectionScopeConfig, err := r.client.CreateGitlabConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), gitlabConnectionScopeConfigCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake gitlab connection scope config",
+			"Could not create devlake gitlab connection scope config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, gitlabConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Entities = entitiesVal
+	plan.ID = types.StringValue(strconv.Itoa(gitlabConnectionScopeConfig.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.StringValue(gitlabConnectionScopeConfig.CreatedAt)
+	plan.DeploymentPattern = types.StringValue(gitlabConnectionScopeConfig.DeploymentPattern)
+	plan.EnvNamePattern = types.StringValue(gitlabConnectionScopeConfig.EnvNamePattern)
+	plan.IssueComponent = types.StringValue(gitlabConnectionScopeConfig.IssueComponent)
+	plan.IssuePriority = types.StringValue(gitlabConnectionScopeConfig.IssuePriority)
+	plan.IssueSeverity = types.StringValue(gitlabConnectionScopeConfig.IssueSeverity)
+	plan.IssueTypeBug = types.StringValue(gitlabConnectionScopeConfig.IssueTypeBug)
+	plan.IssueTypeIncident = types.StringValue(gitlabConnectionScopeConfig.IssueTypeIncident)
+	plan.IssueTypeRequirement = types.StringValue(gitlabConnectionScopeConfig.IssueTypeRequirement)
+	plan.Name = types.StringValue(gitlabConnectionScopeConfig.Name)
+	plan.PrBodyClosePattern = types.StringValue(gitlabConnectionScopeConfig.PrBodyClosePattern)
+	plan.PrComponent = types.StringValue(gitlabConnectionScopeConfig.PrComponent)
+	plan.PrType = types.StringValue(gitlabConnectionScopeConfig.PrType)
+	plan.ProductionPattern = types.StringValue(gitlabConnectionScopeConfig.ProductionPattern)
+	plan.RefDiff.TagsLimit = types.Int64Value(int64(gitlabConnectionScopeConfig.RefDiff.TagsLimit))
+	plan.RefDiff.TagsPattern = types.StringValue(gitlabConnectionScopeConfig.RefDiff.TagsPattern)
+	plan.UpdatedAt = types.StringValue(gitlabConnectionScopeConfig.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabConnectionScopeConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state gitlabConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed gitlab connection scope config value from Devlake
+	gitlabConnectionScopeConfig, err := r.client.ReadGitlabConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake gitlab connection scopeconfig",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite gitlab connection scope config with refreshed state
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, gitlabConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CreatedAt = types.StringValue(gitlabConnectionScopeConfig.CreatedAt)
+	state.DeploymentPattern = types.StringValue(gitlabConnectionScopeConfig.DeploymentPattern)
+	state.Entities = entitiesVal
+	state.EnvNamePattern = types.StringValue(gitlabConnectionScopeConfig.EnvNamePattern)
+	state.ID = types.StringValue(strconv.Itoa(gitlabConnectionScopeConfig.ID))
+	state.IssueComponent = types.StringValue(gitlabConnectionScopeConfig.IssueComponent)
+	state.IssuePriority = types.StringValue(gitlabConnectionScopeConfig.IssuePriority)
+	state.IssueSeverity = types.StringValue(gitlabConnectionScopeConfig.IssueSeverity)
+	state.IssueTypeBug = types.StringValue(gitlabConnectionScopeConfig.IssueTypeBug)
+	state.IssueTypeIncident = types.StringValue(gitlabConnectionScopeConfig.IssueTypeIncident)
+	state.IssueTypeRequirement = types.StringValue(gitlabConnectionScopeConfig.IssueTypeRequirement)
+	state.Name = types.StringValue(gitlabConnectionScopeConfig.Name)
+	state.PrBodyClosePattern = types.StringValue(gitlabConnectionScopeConfig.PrBodyClosePattern)
+	state.PrComponent = types.StringValue(gitlabConnectionScopeConfig.PrComponent)
+	state.PrType = types.StringValue(gitlabConnectionScopeConfig.PrType)
+	state.ProductionPattern = types.StringValue(gitlabConnectionScopeConfig.ProductionPattern)
+	state.UpdatedAt = types.StringValue(gitlabConnectionScopeConfig.UpdatedAt)
+	if apiRefDiff := gitlabConnectionScopeConfig.RefDiff; apiRefDiff != nil {
+		state.RefDiff = &refDiff{
+			TagsLimit:   types.Int64Value(int64(apiRefDiff.TagsLimit)),
+			TagsPattern: types.StringValue(apiRefDiff.TagsPattern),
+		}
+	} else {
+		state.RefDiff = nil
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *gitlabConnectionScopeConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan gitlabConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection scopeconfig",
+			"Could not update devlake gitlab connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection scopeconfig",
+			"Could not update devlake gitlab connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	var gitlabConnectionScopeConfigUpdate = client.GitlabConnectionScopeConfig{
+		ConnectionId:         connectionId,
+		ID:                   id,
+		DeploymentPattern:    plan.DeploymentPattern.ValueString(),
+		Entities:             entities,
+		EnvNamePattern:       plan.EnvNamePattern.ValueString(),
+		IssueComponent:       plan.IssueComponent.ValueString(),
+		IssuePriority:        plan.IssuePriority.ValueString(),
+		IssueSeverity:        plan.IssueSeverity.ValueString(),
+		IssueTypeBug:         plan.IssueTypeBug.ValueString(),
+		IssueTypeIncident:    plan.IssueTypeIncident.ValueString(),
+		IssueTypeRequirement: plan.IssueTypeRequirement.ValueString(),
+		Name:                 plan.Name.ValueString(),
+		PrBodyClosePattern:   plan.PrBodyClosePattern.ValueString(),
+		PrComponent:          plan.PrComponent.ValueString(),
+		PrType:               plan.PrType.ValueString(),
+		ProductionPattern:    plan.ProductionPattern.ValueString(),
+		RefDiff: &client.RefDiff{
+			TagsLimit:   int(plan.RefDiff.TagsLimit.ValueInt64()),
+			TagsPattern: plan.RefDiff.TagsPattern.ValueString(),
+		},
+		UpdatedAt: time.Now().Format(time.RFC850),
+	}
+
+	// Update existing connection scope config
+	updatedGitlabConnectionScopeConfig, err := r.client.UpdateGitlabConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), gitlabConnectionScopeConfigUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake gitlab connection scopeconfig",
+			"Could not update devlake gitlab connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, updatedGitlabConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.CreatedAt = types.StringValue(updatedGitlabConnectionScopeConfig.CreatedAt)
+	plan.DeploymentPattern = types.StringValue(updatedGitlabConnectionScopeConfig.DeploymentPattern)
+	plan.Entities = entitiesVal
+	plan.EnvNamePattern = types.StringValue(updatedGitlabConnectionScopeConfig.EnvNamePattern)
+	plan.IssueComponent = types.StringValue(updatedGitlabConnectionScopeConfig.IssueComponent)
+	plan.IssuePriority = types.StringValue(updatedGitlabConnectionScopeConfig.IssuePriority)
+	plan.IssueSeverity = types.StringValue(updatedGitlabConnectionScopeConfig.IssueSeverity)
+	plan.IssueTypeBug = types.StringValue(updatedGitlabConnectionScopeConfig.IssueTypeBug)
+	plan.IssueTypeIncident = types.StringValue(updatedGitlabConnectionScopeConfig.IssueTypeIncident)
+	plan.IssueTypeRequirement = types.StringValue(updatedGitlabConnectionScopeConfig.IssueTypeRequirement)
+	plan.Name = types.StringValue(updatedGitlabConnectionScopeConfig.Name)
+	plan.PrBodyClosePattern = types.StringValue(updatedGitlabConnectionScopeConfig.PrBodyClosePattern)
+	plan.PrComponent = types.StringValue(updatedGitlabConnectionScopeConfig.PrComponent)
+	plan.PrType = types.StringValue(updatedGitlabConnectionScopeConfig.PrType)
+	plan.ProductionPattern = types.StringValue(updatedGitlabConnectionScopeConfig.ProductionPattern)
+	plan.RefDiff.TagsLimit = types.Int64Value(int64(updatedGitlabConnectionScopeConfig.RefDiff.TagsLimit))
+	plan.RefDiff.TagsPattern = types.StringValue(updatedGitlabConnectionScopeConfig.RefDiff.TagsPattern)
+	plan.UpdatedAt = types.StringValue(updatedGitlabConnectionScopeConfig.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *gitlabConnectionScopeConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state gitlabConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope config
+	err := r.client.DeleteGitlabConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake gitlab connection scopeconfig",
+			"Could not delete devlake gitlab connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *gitlabConnectionScopeConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scopeconfig_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabConnectionScopeConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
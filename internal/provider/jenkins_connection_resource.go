@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jenkinsConnectionResource{}
+	_ resource.ResourceWithConfigure   = &jenkinsConnectionResource{}
+	_ resource.ResourceWithImportState = &jenkinsConnectionResource{}
+)
+
+// NewJenkinsConnectionResource is a helper function to simplify the provider implementation.
+func NewJenkinsConnectionResource() resource.Resource {
+	return &jenkinsConnectionResource{}
+}
+
+// jenkinsConnectionResource is the resource implementation.
+type jenkinsConnectionResource struct {
+	client *client.Client
+}
+
+// jenkinsConnectionResourceModel maps the resource schema data.
+type jenkinsConnectionResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Endpoint         types.String   `tfsdk:"endpoint"`
+	Name             types.String   `tfsdk:"name"`
+	Password         types.String   `tfsdk:"password"`
+	Proxy            types.String   `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64    `tfsdk:"rate_limit_per_hour"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Username         types.String   `tfsdk:"username"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *jenkinsConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jenkins_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *jenkinsConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was created in devlake.",
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The base endpoint URL of the jenkins server, e.g. 'https://jenkins.example.com/'.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the jenkins connection.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password or API token of the jenkins user, the user needs read access to the jobs to collect.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"proxy": schema.StringAttribute{
+				Computed:    true,
+				Description: "If you are behind a corporate firewall or VPN you may need to utilize a proxy server.",
+				Optional:    true,
+			},
+			"rate_limit_per_hour": schema.Int64Attribute{
+				Optional:    true,
+				Description: "DevLake uses a dynamic rate limit to collect Jenkins data. You can adjust the rate limit if you want to increase or lower the speed.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was updated in devlake.",
+			},
+			"username": schema.StringAttribute{
+				Description: "The jenkins username.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *jenkinsConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jenkinsConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	now := time.Now().Format(time.RFC850)
+
+	// Generate API request body from plan
+	var jenkinsConnectionCreate = client.JenkinsConnection{
+		CreatedAt:        now,
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Password:         plan.Password.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		UpdatedAt:        now,
+		Username:         plan.Username.ValueString(),
+	}
+
+	// Create new jenkinsconnection
+	jenkinsConnection, err := r.client.CreateJenkinsConnection(ctx, jenkinsConnectionCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jenkins connection",
+			"Could not create devlake jenkins connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(jenkinsConnection.ID))
+	plan.LastUpdated = types.StringValue(now)
+	plan.CreatedAt = types.StringValue(jenkinsConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(jenkinsConnection.Endpoint)
+	plan.Name = types.StringValue(jenkinsConnection.Name)
+	plan.Proxy = types.StringValue(jenkinsConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(jenkinsConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(jenkinsConnection.UpdatedAt)
+	plan.Username = types.StringValue(jenkinsConnection.Username)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jenkinsConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jenkinsConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed jenkins connection value from Devlake
+	jenkinsConnection, err := r.client.ReadJenkinsConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake jenkins connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(jenkinsConnection.ID))
+	state.CreatedAt = types.StringValue(jenkinsConnection.CreatedAt)
+	state.Endpoint = types.StringValue(jenkinsConnection.Endpoint)
+	state.Name = types.StringValue(jenkinsConnection.Name)
+	state.Proxy = types.StringValue(jenkinsConnection.Proxy)
+	state.RateLimitPerHour = types.Int64Value(int64(jenkinsConnection.RateLimitPerHour))
+	state.UpdatedAt = types.StringValue(jenkinsConnection.UpdatedAt)
+	state.Username = types.StringValue(jenkinsConnection.Username)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *jenkinsConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jenkinsConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jenkins connection",
+			"Could not update devlake jenkins connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var jenkinsConnectionUpdate = client.JenkinsConnection{
+		ID:               id,
+		CreatedAt:        plan.CreatedAt.ValueString(),
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Password:         plan.Password.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		UpdatedAt:        time.Now().Format(time.RFC850),
+		Username:         plan.Username.ValueString(),
+	}
+
+	// Update existing connection
+	updatedJenkinsConnection, err := r.client.UpdateJenkinsConnection(ctx, plan.ID.ValueString(), jenkinsConnectionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jenkins connection",
+			"Could not update devlake jenkins connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(updatedJenkinsConnection.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.StringValue(updatedJenkinsConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(updatedJenkinsConnection.Endpoint)
+	plan.Name = types.StringValue(updatedJenkinsConnection.Name)
+	plan.Proxy = types.StringValue(updatedJenkinsConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(updatedJenkinsConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(updatedJenkinsConnection.UpdatedAt)
+	plan.Username = types.StringValue(updatedJenkinsConnection.Username)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jenkinsConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jenkinsConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection
+	err := r.client.DeleteJenkinsConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake jenkins connection",
+			"Could not delete devlake jenkins connection, unexpected error: "+err.Error()+"..",
+		)
+		return
+	}
+}
+
+func (r *jenkinsConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jenkinsConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	jenkinsConnectionConfig = providerConfig + `
+resource "devlake_jenkins_connection" "jenkins" {
+  endpoint  = "https://jenkins.example.com/"
+  name      = "should_not_exist"
+  password  = "whatever"
+  username  = "devlake"
+}
+`
+)
+
+func TestAccJenkinsConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: jenkinsConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "endpoint", "https://jenkins.example.com/"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "password", "whatever"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "username", "devlake"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_jenkins_connection.jenkins",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_jenkins_connection.jenkins"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_jenkins_connection.jenkins not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"password", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_jenkins_connection" "jenkins" {
+  endpoint  = "https://jenkins2.example.com/"
+  name      = "should_not_exist"
+  password  = "whatever"
+  username  = "devlake"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "endpoint", "https://jenkins2.example.com/"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "password", "whatever"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection.jenkins", "username", "devlake"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection.jenkins", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
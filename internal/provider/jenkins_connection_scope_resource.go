@@ -0,0 +1,419 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jenkinsConnectionScopeResource{}
+	_ resource.ResourceWithConfigure   = &jenkinsConnectionScopeResource{}
+	_ resource.ResourceWithImportState = &jenkinsConnectionScopeResource{}
+)
+
+// NewJenkinsConnectionScopeResource is a helper function to simplify the provider implementation.
+func NewJenkinsConnectionScopeResource() resource.Resource {
+	return &jenkinsConnectionScopeResource{}
+}
+
+// jenkinsConnectionScopeResource is the resource implementation.
+type jenkinsConnectionScopeResource struct {
+	client *client.Client
+}
+
+// jenkinsConnectionScopeResourceModel maps the resource schema data.
+type jenkinsConnectionScopeResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	ConnectionId  types.String   `tfsdk:"connection_id"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Description   types.String   `tfsdk:"description"`
+	Name          types.String   `tfsdk:"name"`
+	Path          types.String   `tfsdk:"path"`
+	ScopeConfigId types.String   `tfsdk:"scope_config_id"`
+	Url           types.String   `tfsdk:"url"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *jenkinsConnectionScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jenkins_connection_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *jenkinsConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The full name of the Jenkins job including its folders, e.g. 'folder/subfolder/job'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection this scope is part of.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A description for the connection scope.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the job. Defaults to the last part of the full name.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "The path of the folder containing the job as used in the Jenkins API, e.g. 'job/folder/job/subfolder/'. Defaults to the path derived from the full name.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for the scope. Needs to be created first.",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The url of the job. Defaults to the url derived from the endpoint of the connection and the path.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *jenkinsConnectionScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jenkinsConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	jenkinsConnectionScopeCreate, err := r.scopeFromModel(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jenkins connection scope",
+			"Could not create devlake jenkins connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC3339)
+	jenkinsConnectionScopeCreate.CreatedAt = now
+	jenkinsConnectionScopeCreate.UpdatedAt = now
+
+	// Create new jenkins connection scope
+	jenkinsConnectionScope, err := r.client.CreateJenkinsConnectionScope(ctx, plan.ConnectionId.ValueString(), *jenkinsConnectionScopeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jenkins connection scope",
+			"Could not create devlake jenkins connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	jenkinsConnectionScopeToModel(jenkinsConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jenkinsConnectionScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jenkinsConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed jenkins connection scope value from Devlake
+	jenkinsConnectionScope, err := r.client.ReadJenkinsConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake jenkins connection scope",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection scope with refreshed state
+	jenkinsConnectionScopeToModel(jenkinsConnectionScope, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *jenkinsConnectionScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jenkinsConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	jenkinsConnectionScopeUpdate, err := r.scopeFromModel(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jenkins connection scope",
+			"Could not update devlake jenkins connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	jenkinsConnectionScopeUpdate.CreatedAt = plan.CreatedAt.ValueString()
+	jenkinsConnectionScopeUpdate.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	// Update existing connection scope
+	updatedJenkinsConnectionScope, err := r.client.UpdateJenkinsConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), *jenkinsConnectionScopeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jenkins connection scope",
+			"Could not update devlake jenkins connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	jenkinsConnectionScopeToModel(updatedJenkinsConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jenkinsConnectionScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jenkinsConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope
+	err := r.client.DeleteJenkinsConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake jenkins connection scope",
+			"Could not delete devlake jenkins connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *jenkinsConnectionScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute, the job
+	// full name is everything after the first comma.
+	idParts := strings.SplitN(req.ID, ",", 2)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,job_full_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// scopeFromModel generates the API request body from the plan. The name,
+// path and url of the job are derived from its full name and the endpoint of
+// the connection unless they are configured.
+func (r *jenkinsConnectionScopeResource) scopeFromModel(ctx context.Context, plan jenkinsConnectionScopeResourceModel) (*client.JenkinsConnectionScope, error) {
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	fullName := plan.ID.ValueString()
+	name := plan.Name.ValueString()
+	if !isKnown(plan.Name) {
+		name = fullName[strings.LastIndex(fullName, "/")+1:]
+	}
+	jobPath := plan.Path.ValueString()
+	if !isKnown(plan.Path) {
+		jobPath = jenkinsJobPath(fullName)
+	}
+	jobUrl := plan.Url.ValueString()
+	if !isKnown(plan.Url) {
+		jenkinsConnection, err := r.client.ReadJenkinsConnection(ctx, plan.ConnectionId.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		jobUrl = strings.TrimSuffix(jenkinsConnection.Endpoint, "/") + "/" + jobPath + "job/" + url.PathEscape(name) + "/"
+	}
+
+	return &client.JenkinsConnectionScope{
+		ConnectionId:  connectionId,
+		Description:   plan.Description.ValueString(),
+		FullName:      fullName,
+		Name:          name,
+		Path:          jobPath,
+		ScopeConfigId: scopeConfigId,
+		Url:           jobUrl,
+	}, nil
+}
+
+// jenkinsJobPath returns the Jenkins API path of the folders containing a
+// job, e.g. 'job/folder/job/subfolder/' for 'folder/subfolder/job'.
+func jenkinsJobPath(fullName string) string {
+	var jobPath string
+	folders := strings.Split(fullName, "/")
+	for _, folder := range folders[:len(folders)-1] {
+		jobPath += "job/" + url.PathEscape(folder) + "/"
+	}
+	return jobPath
+}
+
+// jenkinsConnectionScopeToModel maps a jenkins connection scope returned by
+// devlake to the resource model.
+func jenkinsConnectionScopeToModel(scope *client.JenkinsConnectionScope, model *jenkinsConnectionScopeResourceModel) {
+	model.ID = types.StringValue(scope.FullName)
+	model.ConnectionId = types.StringValue(strconv.Itoa(scope.ConnectionId))
+	model.CreatedAt = types.StringValue(scope.CreatedAt)
+	model.Description = types.StringValue(scope.Description)
+	model.Name = types.StringValue(scope.Name)
+	model.Path = types.StringValue(scope.Path)
+	model.ScopeConfigId = types.StringValue(strconv.Itoa(scope.ScopeConfigId))
+	model.Url = types.StringValue(scope.Url)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jenkinsConnectionScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	jenkinsConnectionScopeConfig = jenkinsConnectionScopeConfigConfig + `
+resource "devlake_jenkins_connection_scope" "scope" {
+  id = "folder/deploy"
+  connection_id	= devlake_jenkins_connection.jenkins.id
+  description = "example job"
+  scope_config_id = devlake_jenkins_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccJenkinsConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: jenkinsConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "description", "example job"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "id", "folder/deploy"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "name", "deploy"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "path", "job/folder/"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "url", "https://jenkins.example.com/job/folder/job/deploy/"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_jenkins_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_jenkins_connection.jenkins"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jenkins_connection.jenkins not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_jenkins_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jenkins_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "scope_config_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: jenkinsConnectionScopeConfigConfig + `
+resource "devlake_jenkins_connection_scope" "scope" {
+  id = "folder/deploy"
+  connection_id	= devlake_jenkins_connection.jenkins.id
+  description = "example deploy job"
+  scope_config_id = devlake_jenkins_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "description", "example deploy job"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "id", "folder/deploy"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "name", "deploy"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "path", "job/folder/"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scope.scope", "url", "https://jenkins.example.com/job/folder/job/deploy/"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	jenkinsConnectionScopeConfigConfig = jenkinsConnectionConfig + `
+resource "devlake_jenkins_connection_scopeconfig" "scopeconf" {
+  connection_id	= devlake_jenkins_connection.jenkins.id
+  name          = "conf1"
+}
+`
+)
+
+func TestAccJenkinsConnectionScopeConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: jenkinsConnectionScopeConfigConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "name", "conf1"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "entities.#", "1"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "entities.0", "CICD"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "deployment_pattern", "(deploy|push-image)"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "production_pattern", "(prod|release)"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_jenkins_connection_scopeconfig.scopeconf",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeConfigId string
+					if con, ok := s.RootModule().Resources["devlake_jenkins_connection.jenkins"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jenkins_connection.jenkins not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_jenkins_connection_scopeconfig.scopeconf"]; ok {
+						scopeConfigId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jenkins_connection_scopeconfig.scopeconf not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeConfigId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id"},
+			},
+			// Update and Read testing
+			{
+				Config: jenkinsConnectionConfig + `
+resource "devlake_jenkins_connection_scopeconfig" "scopeconf" {
+  connection_id	= devlake_jenkins_connection.jenkins.id
+  name               = "conf2"
+  deployment_pattern = "deploy"
+  production_pattern = "prod"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "name", "conf2"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "entities.#", "1"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "entities.0", "CICD"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "deployment_pattern", "deploy"),
+					resource.TestCheckResourceAttr("devlake_jenkins_connection_scopeconfig.scopeconf", "production_pattern", "prod"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jenkins_connection_scopeconfig.scopeconf", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
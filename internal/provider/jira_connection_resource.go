@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &jiraConnectionResource{}
+	_ resource.ResourceWithConfigure      = &jiraConnectionResource{}
+	_ resource.ResourceWithImportState    = &jiraConnectionResource{}
+	_ resource.ResourceWithValidateConfig = &jiraConnectionResource{}
+)
+
+// NewJiraConnectionResource is a helper function to simplify the provider implementation.
+func NewJiraConnectionResource() resource.Resource {
+	return &jiraConnectionResource{}
+}
+
+// jiraConnectionResource is the resource implementation.
+type jiraConnectionResource struct {
+	client *client.Client
+}
+
+// jiraConnectionResourceModel maps the resource schema data.
+type jiraConnectionResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	AuthMethod       types.String   `tfsdk:"auth_method"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Endpoint         types.String   `tfsdk:"endpoint"`
+	Name             types.String   `tfsdk:"name"`
+	Password         types.String   `tfsdk:"password"`
+	Proxy            types.String   `tfsdk:"proxy"`
+	RateLimitPerHour types.Int64    `tfsdk:"rate_limit_per_hour"`
+	Token            types.String   `tfsdk:"token"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Username         types.String   `tfsdk:"username"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *jiraConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *jiraConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection.",
+			},
+			"auth_method": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("BasicAuth"),
+				Description: "The authentication method, either 'BasicAuth' with username and password (or api token for Jira Cloud) or 'AccessToken' with a personal access token for Jira Server/Data Center. Defaults to 'BasicAuth'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("BasicAuth", "AccessToken"),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was created in devlake.",
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The base endpoint URL, e.g. 'https://your-domain.atlassian.net/rest/' for Jira Cloud.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the jira connection.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password or api token of the user, required for auth method 'BasicAuth'.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"proxy": schema.StringAttribute{
+				Computed:    true,
+				Description: "If you are behind a corporate firewall or VPN you may need to utilize a proxy server.",
+				Optional:    true,
+			},
+			"rate_limit_per_hour": schema.Int64Attribute{
+				Optional:    true,
+				Description: "DevLake uses a dynamic rate limit to collect Jira data. You can adjust the rate limit if you want to increase or lower the speed.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Personal access token, required for auth method 'AccessToken'.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was updated in devlake.",
+			},
+			"username": schema.StringAttribute{
+				Description: "Username or e-mail of the user, required for auth method 'BasicAuth'.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig ensures the credentials match the auth method.
+func (r *jiraConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jiraConnectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.AuthMethod.IsUnknown() {
+		return
+	}
+
+	if config.AuthMethod.ValueString() == "AccessToken" {
+		if config.Token.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Missing jira access token",
+				"A token is required when the auth method is 'AccessToken'.",
+			)
+		}
+		return
+	}
+
+	// BasicAuth is the default when auth_method is not configured
+	if config.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing jira username",
+			"A username is required when the auth method is 'BasicAuth'.",
+		)
+	}
+	if config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing jira password",
+			"A password is required when the auth method is 'BasicAuth'.",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *jiraConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jiraConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	now := time.Now().Format(time.RFC850)
+
+	// Generate API request body from plan
+	var jiraConnectionCreate = client.JiraConnection{
+		AuthMethod:       plan.AuthMethod.ValueString(),
+		CreatedAt:        now,
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Password:         plan.Password.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		Token:            plan.Token.ValueString(),
+		UpdatedAt:        now,
+		Username:         plan.Username.ValueString(),
+	}
+
+	// Create new jiraconnection
+	jiraConnection, err := r.client.CreateJiraConnection(ctx, jiraConnectionCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jira connection",
+			"Could not create devlake jira connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(jiraConnection.ID))
+	plan.LastUpdated = types.StringValue(now)
+	plan.AuthMethod = types.StringValue(jiraConnection.AuthMethod)
+	plan.CreatedAt = types.StringValue(jiraConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(jiraConnection.Endpoint)
+	plan.Name = types.StringValue(jiraConnection.Name)
+	plan.Proxy = types.StringValue(jiraConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(jiraConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(jiraConnection.UpdatedAt)
+	plan.Username = jiraUsernameToModel(jiraConnection.Username)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jiraConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jiraConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed jira connection value from Devlake
+	jiraConnection, err := r.client.ReadJiraConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake jira connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state, the secrets are not
+	// returned by devlake and are kept as is
+	state.ID = types.StringValue(strconv.Itoa(jiraConnection.ID))
+	state.AuthMethod = types.StringValue(jiraConnection.AuthMethod)
+	state.CreatedAt = types.StringValue(jiraConnection.CreatedAt)
+	state.Endpoint = types.StringValue(jiraConnection.Endpoint)
+	state.Name = types.StringValue(jiraConnection.Name)
+	state.Proxy = types.StringValue(jiraConnection.Proxy)
+	state.RateLimitPerHour = types.Int64Value(int64(jiraConnection.RateLimitPerHour))
+	state.UpdatedAt = types.StringValue(jiraConnection.UpdatedAt)
+	state.Username = jiraUsernameToModel(jiraConnection.Username)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *jiraConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jiraConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection",
+			"Could not update devlake jira connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var jiraConnectionUpdate = client.JiraConnection{
+		ID:               id,
+		AuthMethod:       plan.AuthMethod.ValueString(),
+		CreatedAt:        plan.CreatedAt.ValueString(),
+		Endpoint:         plan.Endpoint.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Password:         plan.Password.ValueString(),
+		Proxy:            plan.Proxy.ValueString(),
+		RateLimitPerHour: int(plan.RateLimitPerHour.ValueInt64()),
+		Token:            plan.Token.ValueString(),
+		UpdatedAt:        time.Now().Format(time.RFC850),
+		Username:         plan.Username.ValueString(),
+	}
+
+	// Update existing connection
+	updatedJiraConnection, err := r.client.UpdateJiraConnection(ctx, plan.ID.ValueString(), jiraConnectionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection",
+			"Could not update devlake jira connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(updatedJiraConnection.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.AuthMethod = types.StringValue(updatedJiraConnection.AuthMethod)
+	plan.CreatedAt = types.StringValue(updatedJiraConnection.CreatedAt)
+	plan.Endpoint = types.StringValue(updatedJiraConnection.Endpoint)
+	plan.Name = types.StringValue(updatedJiraConnection.Name)
+	plan.Proxy = types.StringValue(updatedJiraConnection.Proxy)
+	plan.RateLimitPerHour = types.Int64Value(int64(updatedJiraConnection.RateLimitPerHour))
+	plan.UpdatedAt = types.StringValue(updatedJiraConnection.UpdatedAt)
+	plan.Username = jiraUsernameToModel(updatedJiraConnection.Username)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jiraConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jiraConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection
+	err := r.client.DeleteJiraConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake jira connection",
+			"Could not delete devlake jira connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *jiraConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jiraConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// jiraUsernameToModel maps the username of a jira connection, which is empty
+// for the 'AccessToken' auth method.
+func jiraUsernameToModel(username string) types.String {
+	if username == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(username)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	jiraConnectionConfig = providerConfig + `
+resource "devlake_jira_connection" "jira" {
+  endpoint  = "https://jira.example.org/rest/"
+  name      = "should_not_exist"
+  password  = "whatever"
+  username  = "serviceAccount"
+}
+`
+)
+
+func TestAccJiraConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: jiraConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "auth_method", "BasicAuth"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "endpoint", "https://jira.example.org/rest/"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "password", "whatever"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "username", "serviceAccount"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_jira_connection.jira",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_jira_connection.jira"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_jira_connection.jira not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"password", "token", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_jira_connection" "jira" {
+  auth_method = "AccessToken"
+  endpoint    = "https://jira.example.org/rest/"
+  name        = "should_not_exist"
+  token       = "whatever"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "auth_method", "AccessToken"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "endpoint", "https://jira.example.org/rest/"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "rate_limit_per_hour", "0"),
+					resource.TestCheckResourceAttr("devlake_jira_connection.jira", "token", "whatever"),
+					resource.TestCheckNoResourceAttr("devlake_jira_connection.jira", "username"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection.jira", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
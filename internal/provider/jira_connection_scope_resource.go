@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jiraConnectionScopeResource{}
+	_ resource.ResourceWithConfigure   = &jiraConnectionScopeResource{}
+	_ resource.ResourceWithImportState = &jiraConnectionScopeResource{}
+)
+
+// NewJiraConnectionScopeResource is a helper function to simplify the provider implementation.
+func NewJiraConnectionScopeResource() resource.Resource {
+	return &jiraConnectionScopeResource{}
+}
+
+// jiraConnectionScopeResource is the resource implementation.
+type jiraConnectionScopeResource struct {
+	client *client.Client
+}
+
+// jiraConnectionScopeResourceModel maps the resource schema data.
+type jiraConnectionScopeResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	ConnectionId  types.String   `tfsdk:"connection_id"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Name          types.String   `tfsdk:"name"`
+	ProjectId     types.Int64    `tfsdk:"project_id"`
+	ScopeConfigId types.String   `tfsdk:"scope_config_id"`
+	Self          types.String   `tfsdk:"self"`
+	Type          types.String   `tfsdk:"type"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *jiraConnectionScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_connection_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *jiraConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The numeric id of the board in jira.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection this scope is part of.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the board.",
+				Required:    true,
+			},
+			"project_id": schema.Int64Attribute{
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The numeric id of the jira project the board is located in.",
+				Optional:    true,
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for the scope. Needs to be created first.",
+				Required:    true,
+			},
+			"self": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The api url of the board, e.g. 'https://your-domain.atlassian.net/rest/agile/1.0/board/42'.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString("scrum"),
+				Description: "The type of the board, one of 'scrum', 'kanban' or 'simple'. Defaults to 'scrum'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("scrum", "kanban", "simple"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *jiraConnectionScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jiraConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jira connection scope",
+			"Could not create devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jira connection scope",
+			"Could not create devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jira connection scope",
+			"Could not create devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC3339)
+	var jiraConnectionScopeCreate = client.JiraConnectionScope{
+		BoardId:       id,
+		ConnectionId:  connectionId,
+		CreatedAt:     now,
+		Name:          plan.Name.ValueString(),
+		ProjectId:     int(plan.ProjectId.ValueInt64()),
+		ScopeConfigId: scopeConfigId,
+		Self:          plan.Self.ValueString(),
+		Type:          plan.Type.ValueString(),
+		UpdatedAt:     now,
+	}
+
+	// Create new jiraconnectionscope
+	jiraConnectionScope, err := r.client.CreateJiraConnectionScope(ctx, plan.ConnectionId.ValueString(), jiraConnectionScopeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jira connection scope",
+			"Could not create devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(jiraConnectionScope.BoardId))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	plan.ConnectionId = types.StringValue(strconv.Itoa(jiraConnectionScope.ConnectionId))
+	plan.CreatedAt = types.StringValue(jiraConnectionScope.CreatedAt)
+	plan.Name = types.StringValue(jiraConnectionScope.Name)
+	plan.ProjectId = types.Int64Value(int64(jiraConnectionScope.ProjectId))
+	plan.ScopeConfigId = types.StringValue(strconv.Itoa(jiraConnectionScope.ScopeConfigId))
+	plan.Self = types.StringValue(jiraConnectionScope.Self)
+	plan.Type = types.StringValue(jiraConnectionScope.Type)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jiraConnectionScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jiraConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed jira connection scope value from Devlake
+	jiraConnectionScope, err := r.client.ReadJiraConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake jira connection scope",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(jiraConnectionScope.BoardId))
+	state.ConnectionId = types.StringValue(strconv.Itoa(jiraConnectionScope.ConnectionId))
+	state.CreatedAt = types.StringValue(jiraConnectionScope.CreatedAt)
+	state.Name = types.StringValue(jiraConnectionScope.Name)
+	state.ProjectId = types.Int64Value(int64(jiraConnectionScope.ProjectId))
+	state.ScopeConfigId = types.StringValue(strconv.Itoa(jiraConnectionScope.ScopeConfigId))
+	state.Self = types.StringValue(jiraConnectionScope.Self)
+	state.Type = types.StringValue(jiraConnectionScope.Type)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *jiraConnectionScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jiraConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection scope",
+			"Could not update devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection scope",
+			"Could not update devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection scope",
+			"Could not update devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var jiraConnectionScopeUpdate = client.JiraConnectionScope{
+		BoardId:       id,
+		ConnectionId:  connectionId,
+		CreatedAt:     plan.CreatedAt.ValueString(),
+		Name:          plan.Name.ValueString(),
+		ProjectId:     int(plan.ProjectId.ValueInt64()),
+		ScopeConfigId: scopeConfigId,
+		Self:          plan.Self.ValueString(),
+		Type:          plan.Type.ValueString(),
+		UpdatedAt:     time.Now().Format(time.RFC3339),
+	}
+
+	// Update existing connection scope
+	updatedJiraConnectionScope, err := r.client.UpdateJiraConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), jiraConnectionScopeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection scope",
+			"Could not update devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(strconv.Itoa(updatedJiraConnectionScope.BoardId))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	plan.ConnectionId = types.StringValue(strconv.Itoa(updatedJiraConnectionScope.ConnectionId))
+	plan.CreatedAt = types.StringValue(updatedJiraConnectionScope.CreatedAt)
+	plan.Name = types.StringValue(updatedJiraConnectionScope.Name)
+	plan.ProjectId = types.Int64Value(int64(updatedJiraConnectionScope.ProjectId))
+	plan.ScopeConfigId = types.StringValue(strconv.Itoa(updatedJiraConnectionScope.ScopeConfigId))
+	plan.Self = types.StringValue(updatedJiraConnectionScope.Self)
+	plan.Type = types.StringValue(updatedJiraConnectionScope.Type)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jiraConnectionScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jiraConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope
+	err := r.client.DeleteJiraConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake jira connection scope",
+			"Could not delete devlake jira connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *jiraConnectionScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scope_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jiraConnectionScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	jiraConnectionScopeConfig = jiraConnectionScopeConfigConfig + `
+resource "devlake_jira_connection_scope" "scope" {
+  id              = "42"
+  connection_id	  = devlake_jira_connection.jira.id
+  name            = "TEAM board"
+  scope_config_id = devlake_jira_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccJiraConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: jiraConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "id", "42"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "name", "TEAM board"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "project_id", "0"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "self", ""),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "type", "scrum"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_jira_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_jira_connection.jira"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jira_connection.jira not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_jira_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jira_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "scope_config_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: jiraConnectionScopeConfigConfig + `
+resource "devlake_jira_connection_scope" "scope" {
+  id              = "42"
+  connection_id	  = devlake_jira_connection.jira.id
+  name            = "TEAM kanban board"
+  project_id      = 10000
+  scope_config_id = devlake_jira_connection_scopeconfig.scopeconf.id
+  self            = "https://jira.example.org/rest/agile/1.0/board/42"
+  type            = "kanban"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "id", "42"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "name", "TEAM kanban board"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "project_id", "10000"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "self", "https://jira.example.org/rest/agile/1.0/board/42"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scope.scope", "type", "kanban"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
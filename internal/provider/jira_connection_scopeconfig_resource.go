@@ -0,0 +1,494 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jiraConnectionScopeConfigResource{}
+	_ resource.ResourceWithConfigure   = &jiraConnectionScopeConfigResource{}
+	_ resource.ResourceWithImportState = &jiraConnectionScopeConfigResource{}
+)
+
+// NewJiraConnectionScopeConfigResource is a helper function to simplify the provider implementation.
+func NewJiraConnectionScopeConfigResource() resource.Resource {
+	return &jiraConnectionScopeConfigResource{}
+}
+
+// jiraConnectionScopeConfigResource is the resource implementation.
+type jiraConnectionScopeConfigResource struct {
+	client *client.Client
+}
+
+// jiraConnectionScopeConfigResourceModel maps the resource schema data.
+type jiraConnectionScopeConfigResourceModel struct {
+	ID                         types.String                    `tfsdk:"id"`
+	LastUpdated                types.String                    `tfsdk:"last_updated"`
+	ConnectionId               types.String                    `tfsdk:"connection_id"`
+	CreatedAt                  types.String                    `tfsdk:"created_at"`
+	Entities                   types.List                      `tfsdk:"entities"`
+	Name                       types.String                    `tfsdk:"name"`
+	RemotelinkCommitShaPattern types.String                    `tfsdk:"remotelink_commit_sha_pattern"`
+	StoryPointField            types.String                    `tfsdk:"story_point_field"`
+	TypeMappings               map[string]jiraTypeMappingModel `tfsdk:"type_mappings"`
+	UpdatedAt                  types.String                    `tfsdk:"updated_at"`
+	Timeouts                   timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// jiraTypeMappingModel maps the issue type mapping schema data.
+type jiraTypeMappingModel struct {
+	StandardType   types.String            `tfsdk:"standard_type"`
+	StatusMappings map[string]types.String `tfsdk:"status_mappings"`
+}
+
+// Metadata returns the resource type name.
+func (r *jiraConnectionScopeConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_connection_scopeconfig"
+}
+
+// Schema defines the schema for the resource.
+func (r *jiraConnectionScopeConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection scope config. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope config.",
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The connection this scope config belongs to.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope config was created in devlake.",
+			},
+			"entities": schema.ListAttribute{
+				Computed:    true,
+				Description: "The data entities to collect, defaults to all applicable for jira.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Default: listdefault.StaticValue(types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("TICKET"),
+						types.StringValue("CROSS"),
+					},
+				)),
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the scope config.",
+				Required:    true,
+			},
+			"remotelink_commit_sha_pattern": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Regex to match the commit sha in the remote links of issues, e.g. '/commit/([0-9a-f]{40})$'. Used to connect issues to commits.",
+				Optional:    true,
+			},
+			"story_point_field": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The id of the custom field holding the story points, e.g. 'customfield_10024'.",
+				Optional:    true,
+			},
+			"type_mappings": schema.MapNestedAttribute{
+				Description: "Mappings of jira issue types to devlake standard types, keyed by the jira issue type name, e.g. 'Story'.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"standard_type": schema.StringAttribute{
+							Description: "The devlake standard type, one of 'REQUIREMENT', 'BUG' or 'INCIDENT'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("REQUIREMENT", "BUG", "INCIDENT"),
+							},
+						},
+						"status_mappings": schema.MapAttribute{
+							Description: "Mappings of jira statuses of the issue type to devlake standard statuses, which are 'TODO', 'IN_PROGRESS' or 'DONE'.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Map{
+								mapvalidator.ValueStringsAre(stringvalidator.OneOf("TODO", "IN_PROGRESS", "DONE")),
+							},
+						},
+					},
+				},
+				Optional: true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope config was updated in devlake.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *jiraConnectionScopeConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jiraConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jira connection scopeconfig",
+			"Could not create devlake jira connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC850)
+	var jiraConnectionScopeConfigCreate = client.JiraConnectionScopeConfig{
+		ConnectionId:               connectionId,
+		CreatedAt:                  now,
+		Entities:                   entities,
+		Name:                       plan.Name.ValueString(),
+		RemotelinkCommitShaPattern: plan.RemotelinkCommitShaPattern.ValueString(),
+		StoryPointField:            plan.StoryPointField.ValueString(),
+		TypeMappings:               jiraTypeMappingsFromModel(plan.TypeMappings),
+		UpdatedAt:                  now,
+	}
+
+	// Create new jiraconnectionscopeconfig
+	jiraConnectionScopeConfig, err := r.client.CreateJiraConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), jiraConnectionScopeConfigCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake jira connection scope config",
+			"Could not create devlake jira connection scope config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, jiraConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Entities = entitiesVal
+	plan.ID = types.StringValue(strconv.Itoa(jiraConnectionScopeConfig.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.StringValue(jiraConnectionScopeConfig.CreatedAt)
+	plan.Name = types.StringValue(jiraConnectionScopeConfig.Name)
+	plan.RemotelinkCommitShaPattern = types.StringValue(jiraConnectionScopeConfig.RemotelinkCommitShaPattern)
+	plan.StoryPointField = types.StringValue(jiraConnectionScopeConfig.StoryPointField)
+	plan.TypeMappings = jiraTypeMappingsToModel(jiraConnectionScopeConfig.TypeMappings)
+	plan.UpdatedAt = types.StringValue(jiraConnectionScopeConfig.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jiraConnectionScopeConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jiraConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed jira connection scope config value from Devlake
+	jiraConnectionScopeConfig, err := r.client.ReadJiraConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake jira connection scopeconfig",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite jira connection scope config with refreshed state
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, jiraConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CreatedAt = types.StringValue(jiraConnectionScopeConfig.CreatedAt)
+	state.Entities = entitiesVal
+	state.ID = types.StringValue(strconv.Itoa(jiraConnectionScopeConfig.ID))
+	state.Name = types.StringValue(jiraConnectionScopeConfig.Name)
+	state.RemotelinkCommitShaPattern = types.StringValue(jiraConnectionScopeConfig.RemotelinkCommitShaPattern)
+	state.StoryPointField = types.StringValue(jiraConnectionScopeConfig.StoryPointField)
+	state.TypeMappings = jiraTypeMappingsToModel(jiraConnectionScopeConfig.TypeMappings)
+	state.UpdatedAt = types.StringValue(jiraConnectionScopeConfig.UpdatedAt)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *jiraConnectionScopeConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jiraConnectionScopeConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection scopeconfig",
+			"Could not update devlake jira connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection scopeconfig",
+			"Could not update devlake jira connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var entities []string
+	if !plan.Entities.IsNull() && !plan.Entities.IsUnknown() {
+		diags = plan.Entities.ElementsAs(ctx, &entities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	var jiraConnectionScopeConfigUpdate = client.JiraConnectionScopeConfig{
+		ConnectionId:               connectionId,
+		CreatedAt:                  plan.CreatedAt.ValueString(),
+		Entities:                   entities,
+		ID:                         id,
+		Name:                       plan.Name.ValueString(),
+		RemotelinkCommitShaPattern: plan.RemotelinkCommitShaPattern.ValueString(),
+		StoryPointField:            plan.StoryPointField.ValueString(),
+		TypeMappings:               jiraTypeMappingsFromModel(plan.TypeMappings),
+		UpdatedAt:                  time.Now().Format(time.RFC850),
+	}
+
+	// Update existing jira connection scope config
+	updatedJiraConnectionScopeConfig, err := r.client.UpdateJiraConnectionScopeConfig(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), jiraConnectionScopeConfigUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake jira connection scopeconfig",
+			"Could not update devlake jira connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	entitiesVal, diags := types.ListValueFrom(ctx, types.StringType, updatedJiraConnectionScopeConfig.Entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Entities = entitiesVal
+	plan.ID = types.StringValue(strconv.Itoa(updatedJiraConnectionScopeConfig.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.StringValue(updatedJiraConnectionScopeConfig.CreatedAt)
+	plan.Name = types.StringValue(updatedJiraConnectionScopeConfig.Name)
+	plan.RemotelinkCommitShaPattern = types.StringValue(updatedJiraConnectionScopeConfig.RemotelinkCommitShaPattern)
+	plan.StoryPointField = types.StringValue(updatedJiraConnectionScopeConfig.StoryPointField)
+	plan.TypeMappings = jiraTypeMappingsToModel(updatedJiraConnectionScopeConfig.TypeMappings)
+	plan.UpdatedAt = types.StringValue(updatedJiraConnectionScopeConfig.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jiraConnectionScopeConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jiraConnectionScopeConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing jira connection scope config
+	err := r.client.DeleteJiraConnectionScopeConfig(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake jira connection scopeconfig",
+			"Could not delete devlake jira connection scopeconfig, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *jiraConnectionScopeConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scope_config_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jiraConnectionScopeConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// jiraTypeMappingsFromModel converts the type mappings of the schema to the
+// nested mappings of the devlake api.
+func jiraTypeMappingsFromModel(typeMappings map[string]jiraTypeMappingModel) map[string]client.JiraTypeMapping {
+	apiTypeMappings := map[string]client.JiraTypeMapping{}
+	for issueType, typeMapping := range typeMappings {
+		statusMappings := map[string]client.JiraStatusMapping{}
+		for status, standardStatus := range typeMapping.StatusMappings {
+			statusMappings[status] = client.JiraStatusMapping{
+				StandardStatus: standardStatus.ValueString(),
+			}
+		}
+		apiTypeMappings[issueType] = client.JiraTypeMapping{
+			StandardType:   typeMapping.StandardType.ValueString(),
+			StatusMappings: statusMappings,
+		}
+	}
+	return apiTypeMappings
+}
+
+// jiraTypeMappingsToModel converts the type mappings of the devlake api to the
+// schema, empty mappings are treated as not configured.
+func jiraTypeMappingsToModel(apiTypeMappings map[string]client.JiraTypeMapping) map[string]jiraTypeMappingModel {
+	if len(apiTypeMappings) == 0 {
+		return nil
+	}
+	typeMappings := map[string]jiraTypeMappingModel{}
+	for issueType, apiTypeMapping := range apiTypeMappings {
+		var statusMappings map[string]types.String
+		if len(apiTypeMapping.StatusMappings) > 0 {
+			statusMappings = map[string]types.String{}
+			for status, apiStatusMapping := range apiTypeMapping.StatusMappings {
+				statusMappings[status] = types.StringValue(apiStatusMapping.StandardStatus)
+			}
+		}
+		typeMappings[issueType] = jiraTypeMappingModel{
+			StandardType:   types.StringValue(apiTypeMapping.StandardType),
+			StatusMappings: statusMappings,
+		}
+	}
+	return typeMappings
+}
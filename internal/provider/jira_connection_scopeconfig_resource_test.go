@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	jiraConnectionScopeConfigConfig = jiraConnectionConfig + `
+resource "devlake_jira_connection_scopeconfig" "scopeconf" {
+  connection_id	= devlake_jira_connection.jira.id
+  name          = "conf1"
+}
+`
+)
+
+func TestAccJiraConnectionScopeConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: jiraConnectionScopeConfigConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "name", "conf1"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "entities.#", "2"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "entities.0", "TICKET"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "entities.1", "CROSS"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "remotelink_commit_sha_pattern", ""),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "story_point_field", ""),
+					resource.TestCheckNoResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "type_mappings"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_jira_connection_scopeconfig.scopeconf",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeConfigId string
+					if con, ok := s.RootModule().Resources["devlake_jira_connection.jira"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jira_connection.jira not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_jira_connection_scopeconfig.scopeconf"]; ok {
+						scopeConfigId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_jira_connection_scopeconfig.scopeconf not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeConfigId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id"},
+			},
+			// Update and Read testing
+			{
+				Config: jiraConnectionConfig + `
+resource "devlake_jira_connection_scopeconfig" "scopeconf" {
+  connection_id	                = devlake_jira_connection.jira.id
+  name                          = "conf2"
+  remotelink_commit_sha_pattern = "/commit/([0-9a-f]{40})$"
+  story_point_field             = "customfield_10024"
+  type_mappings = {
+    Bug = {
+      standard_type   = "BUG"
+      status_mappings = {
+        Open   = "TODO"
+        Closed = "DONE"
+      }
+    }
+    Story = {
+      standard_type = "REQUIREMENT"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "name", "conf2"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "entities.#", "2"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "remotelink_commit_sha_pattern", "/commit/([0-9a-f]{40})$"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "story_point_field", "customfield_10024"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "type_mappings.%", "2"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "type_mappings.Bug.standard_type", "BUG"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "type_mappings.Bug.status_mappings.Open", "TODO"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "type_mappings.Bug.status_mappings.Closed", "DONE"),
+					resource.TestCheckResourceAttr("devlake_jira_connection_scopeconfig.scopeconf", "type_mappings.Story.standard_type", "REQUIREMENT"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "id"),
+					resource.TestCheckResourceAttrSet("devlake_jira_connection_scopeconfig.scopeconf", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	opsgenieConnectionConfig = providerConfig + `
+resource "devlake_opsgenie_connection" "og" {
+  name     = "should_not_exist"
+  token    = "whatever"
+}
+`
+)
+
+func TestAccOpsgenieConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: opsgenieConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "endpoint", "https://api.opsgenie.com/"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "rate_limit_per_hour", "0"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "id"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_opsgenie_connection.og",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_opsgenie_connection.og"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_opsgenie_connection.og not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"token", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_opsgenie_connection" "og" {
+  name     = "should_not_exist2"
+  token    = "whatever"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "endpoint", "https://api.opsgenie.com/"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "name", "should_not_exist2"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection.og", "rate_limit_per_hour", "0"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "id"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection.og", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &opsgenieConnectionScopeResource{}
+	_ resource.ResourceWithConfigure   = &opsgenieConnectionScopeResource{}
+	_ resource.ResourceWithImportState = &opsgenieConnectionScopeResource{}
+)
+
+// NewOpsgenieConnectionScopeResource is a helper function to simplify the provider implementation.
+func NewOpsgenieConnectionScopeResource() resource.Resource {
+	return &opsgenieConnectionScopeResource{}
+}
+
+// opsgenieConnectionScopeResource is the resource implementation.
+type opsgenieConnectionScopeResource struct {
+	client *client.Client
+}
+
+// opsgenieConnectionScopeResourceModel maps the resource schema data.
+type opsgenieConnectionScopeResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	ConnectionId  types.String   `tfsdk:"connection_id"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Name          types.String   `tfsdk:"name"`
+	ScopeConfigId types.String   `tfsdk:"scope_config_id"`
+	TeamId        types.String   `tfsdk:"team_id"`
+	Url           types.String   `tfsdk:"url"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *opsgenieConnectionScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_opsgenie_connection_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *opsgenieConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the opsgenie service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection this scope is part of.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the opsgenie service.",
+				Required:    true,
+			},
+			"scope_config_id": schema.StringAttribute{
+				Description: "The config used for the scope. Needs to be created first.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The id of the opsgenie team owning the service.",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The url of the service in opsgenie.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *opsgenieConnectionScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan opsgenieConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	opsgenieConnectionScopeCreate, err := opsgenieConnectionScopeFromModel(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake opsgenie connection scope",
+			"Could not create devlake opsgenie connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC3339)
+	opsgenieConnectionScopeCreate.CreatedAt = now
+	opsgenieConnectionScopeCreate.UpdatedAt = now
+
+	// Create new opsgenie connection scope
+	opsgenieConnectionScope, err := r.client.CreateOpsgenieConnectionScope(ctx, plan.ConnectionId.ValueString(), *opsgenieConnectionScopeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake opsgenie connection scope",
+			"Could not create devlake opsgenie connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	opsgenieConnectionScopeToModel(opsgenieConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *opsgenieConnectionScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state opsgenieConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed opsgenie connection scope value from Devlake
+	opsgenieConnectionScope, err := r.client.ReadOpsgenieConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake opsgenie connection scope",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection scope with refreshed state
+	opsgenieConnectionScopeToModel(opsgenieConnectionScope, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *opsgenieConnectionScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan opsgenieConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	opsgenieConnectionScopeUpdate, err := opsgenieConnectionScopeFromModel(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake opsgenie connection scope",
+			"Could not update devlake opsgenie connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	opsgenieConnectionScopeUpdate.CreatedAt = plan.CreatedAt.ValueString()
+	opsgenieConnectionScopeUpdate.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	// Update existing connection scope
+	updatedOpsgenieConnectionScope, err := r.client.UpdateOpsgenieConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), *opsgenieConnectionScopeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake opsgenie connection scope",
+			"Could not update devlake opsgenie connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	opsgenieConnectionScopeToModel(updatedOpsgenieConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *opsgenieConnectionScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state opsgenieConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope
+	err := r.client.DeleteOpsgenieConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake opsgenie connection scope",
+			"Could not delete devlake opsgenie connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *opsgenieConnectionScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scope_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// opsgenieConnectionScopeFromModel generates the API request body from the
+// plan.
+func opsgenieConnectionScopeFromModel(plan opsgenieConnectionScopeResourceModel) (*client.OpsgenieConnectionScope, error) {
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	scopeConfigId, err := strconv.Atoi(plan.ScopeConfigId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.OpsgenieConnectionScope{
+		ConnectionId:  connectionId,
+		ID:            plan.ID.ValueString(),
+		Name:          plan.Name.ValueString(),
+		ScopeConfigId: scopeConfigId,
+		TeamId:        plan.TeamId.ValueString(),
+		Url:           plan.Url.ValueString(),
+	}, nil
+}
+
+// opsgenieConnectionScopeToModel maps an opsgenie connection scope returned
+// by devlake to the resource model.
+func opsgenieConnectionScopeToModel(scope *client.OpsgenieConnectionScope, model *opsgenieConnectionScopeResourceModel) {
+	model.ID = types.StringValue(scope.ID)
+	model.ConnectionId = types.StringValue(strconv.Itoa(scope.ConnectionId))
+	model.CreatedAt = types.StringValue(scope.CreatedAt)
+	model.Name = types.StringValue(scope.Name)
+	model.ScopeConfigId = types.StringValue(strconv.Itoa(scope.ScopeConfigId))
+	model.TeamId = types.StringValue(scope.TeamId)
+	model.Url = types.StringValue(scope.Url)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *opsgenieConnectionScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
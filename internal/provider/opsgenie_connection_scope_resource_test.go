@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	opsgenieConnectionScopeConfig = opsgenieConnectionScopeConfigConfig + `
+resource "devlake_opsgenie_connection_scope" "scope" {
+  id = "e0a0e9fb-6d5b-4f64-9f1a-8f3e1d6a3c52"
+  connection_id	= devlake_opsgenie_connection.og.id
+  name = "devlake"
+  scope_config_id = devlake_opsgenie_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccOpsgenieConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: opsgenieConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection_scope.scope", "id", "e0a0e9fb-6d5b-4f64-9f1a-8f3e1d6a3c52"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection_scope.scope", "name", "devlake"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection_scope.scope", "url", ""),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_opsgenie_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_opsgenie_connection.og"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_opsgenie_connection.og not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_opsgenie_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_opsgenie_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "scope_config_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: opsgenieConnectionScopeConfigConfig + `
+resource "devlake_opsgenie_connection_scope" "scope" {
+  id = "e0a0e9fb-6d5b-4f64-9f1a-8f3e1d6a3c52"
+  connection_id	= devlake_opsgenie_connection.og.id
+  name = "devlake-prod"
+  scope_config_id = devlake_opsgenie_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection_scope.scope", "id", "e0a0e9fb-6d5b-4f64-9f1a-8f3e1d6a3c52"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection_scope.scope", "name", "devlake-prod"),
+					resource.TestCheckResourceAttr("devlake_opsgenie_connection_scope.scope", "url", ""),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_opsgenie_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
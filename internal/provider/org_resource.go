@@ -0,0 +1,551 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &orgResource{}
+	_ resource.ResourceWithConfigure   = &orgResource{}
+	_ resource.ResourceWithImportState = &orgResource{}
+)
+
+// orgId is the id of the org resource, the org plugin only holds a single
+// organisation.
+const orgId = "org"
+
+// NewOrgResource is a helper function to simplify the provider implementation.
+func NewOrgResource() resource.Resource {
+	return &orgResource{}
+}
+
+// orgResource is the resource implementation.
+type orgResource struct {
+	client *client.Client
+}
+
+// orgResourceModel maps the resource schema data.
+type orgResourceModel struct {
+	ID                  types.String                 `tfsdk:"id"`
+	LastUpdated         types.String                 `tfsdk:"last_updated"`
+	Teams               []orgTeamModel               `tfsdk:"teams"`
+	UserAccountMappings []orgUserAccountMappingModel `tfsdk:"user_account_mappings"`
+	Users               []orgUserModel               `tfsdk:"users"`
+	Timeouts            timeouts.Value               `tfsdk:"timeouts"`
+}
+
+// orgTeamModel maps the team schema data.
+type orgTeamModel struct {
+	ID        types.String `tfsdk:"id"`
+	Alias     types.String `tfsdk:"alias"`
+	Name      types.String `tfsdk:"name"`
+	ParentId  types.String `tfsdk:"parent_id"`
+	SortIndex types.Int64  `tfsdk:"sort_index"`
+}
+
+// orgUserModel maps the user schema data.
+type orgUserModel struct {
+	ID      types.String   `tfsdk:"id"`
+	Email   types.String   `tfsdk:"email"`
+	Name    types.String   `tfsdk:"name"`
+	TeamIds []types.String `tfsdk:"team_ids"`
+}
+
+// orgUserAccountMappingModel maps the user account mapping schema data.
+type orgUserAccountMappingModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+	UserId    types.String `tfsdk:"user_id"`
+}
+
+// Attribute types of the nested objects, needed for the empty defaults.
+var (
+	orgTeamAttrTypes = map[string]attr.Type{
+		"id":         types.StringType,
+		"alias":      types.StringType,
+		"name":       types.StringType,
+		"parent_id":  types.StringType,
+		"sort_index": types.Int64Type,
+	}
+	orgUserAttrTypes = map[string]attr.Type{
+		"id":       types.StringType,
+		"email":    types.StringType,
+		"name":     types.StringType,
+		"team_ids": types.SetType{ElemType: types.StringType},
+	}
+	orgUserAccountMappingAttrTypes = map[string]attr.Type{
+		"account_id": types.StringType,
+		"user_id":    types.StringType,
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *orgResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org"
+}
+
+// Schema defines the schema for the resource.
+func (r *orgResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the teams, users and user account mappings of the org plugin, which are needed for the team level dashboards. They are uploaded as csv files and devlake replaces all existing records with every upload, so there should be only one devlake_org. Destroying it removes all teams, users and user account mappings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always 'org', used for resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the org.",
+			},
+			"teams": schema.SetNestedAttribute{
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: orgTeamAttrTypes}, []attr.Value{})),
+				Description: "The teams of the org. Defaults to no teams.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the team.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"alias": schema.StringAttribute{
+							Description: "An alias for the team.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the team.",
+							Required:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "The id of the team this team is part of.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"sort_index": schema.Int64Attribute{
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+							Description: "The position of the team among the teams with the same parent. Defaults to '0'.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+				Optional: true,
+			},
+			"user_account_mappings": schema.SetNestedAttribute{
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: orgUserAccountMappingAttrTypes}, []attr.Value{})),
+				Description: "Maps the users to their accounts in the tools collected by devlake. Defaults to no mappings.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Description: "The id of the account in the devlake domain layer, e.g. 'github:GithubAccount:1:123'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"user_id": schema.StringAttribute{
+							Description: "The id of the user.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+				Optional: true,
+			},
+			"users": schema.SetNestedAttribute{
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: orgUserAttrTypes}, []attr.Value{})),
+				Description: "The users of the org. Defaults to no users.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the user.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user.",
+							Required:    true,
+						},
+						"team_ids": schema.SetAttribute{
+							Description: "The ids of the teams the user is part of.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan orgResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Upload the csv files of the org
+	err := r.upload(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake org",
+			"Could not create devlake org, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values
+	plan.ID = types.StringValue(orgId)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state orgResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed org values from Devlake
+	teams, err := r.client.ReadOrgTeams(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake org teams",
+			err.Error(),
+		)
+		return
+	}
+	users, err := r.client.ReadOrgUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake org users",
+			err.Error(),
+		)
+		return
+	}
+	mappings, err := r.client.ReadOrgUserAccountMappings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake org user account mappings",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite org with refreshed state
+	state.ID = types.StringValue(orgId)
+	state.Teams = orgTeamsToModel(teams)
+	state.UserAccountMappings = orgUserAccountMappingsToModel(mappings)
+	state.Users = orgUsersToModel(users)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan orgResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Upload the csv files of the org
+	err := r.upload(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake org",
+			"Could not update devlake org, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(orgId)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *orgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state orgResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Upload empty csv files, in the reverse order of their dependencies
+	err := r.client.UpdateOrgUserAccountMappings(ctx, nil)
+	if err == nil {
+		err = r.client.UpdateOrgUsers(ctx, nil)
+	}
+	if err == nil {
+		err = r.client.UpdateOrgTeams(ctx, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake org",
+			"Could not delete devlake org, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *orgResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != orgId {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier '%s'. Got: %q", orgId, req.ID),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *orgResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// upload replaces the teams, users and user account mappings in devlake, the
+// teams go first as the users reference them.
+func (r *orgResource) upload(ctx context.Context, model orgResourceModel) error {
+	teams := []client.OrgTeam{}
+	for _, team := range model.Teams {
+		teams = append(teams, client.OrgTeam{
+			Alias:     team.Alias.ValueString(),
+			ID:        team.ID.ValueString(),
+			Name:      team.Name.ValueString(),
+			ParentId:  team.ParentId.ValueString(),
+			SortIndex: int(team.SortIndex.ValueInt64()),
+		})
+	}
+	err := r.client.UpdateOrgTeams(ctx, teams)
+	if err != nil {
+		return fmt.Errorf("uploading teams: %w", err)
+	}
+
+	users := []client.OrgUser{}
+	for _, user := range model.Users {
+		teamIds := []string{}
+		for _, teamId := range user.TeamIds {
+			teamIds = append(teamIds, teamId.ValueString())
+		}
+		users = append(users, client.OrgUser{
+			Email:   user.Email.ValueString(),
+			ID:      user.ID.ValueString(),
+			Name:    user.Name.ValueString(),
+			TeamIds: teamIds,
+		})
+	}
+	err = r.client.UpdateOrgUsers(ctx, users)
+	if err != nil {
+		return fmt.Errorf("uploading users: %w", err)
+	}
+
+	mappings := []client.OrgUserAccountMapping{}
+	for _, mapping := range model.UserAccountMappings {
+		mappings = append(mappings, client.OrgUserAccountMapping{
+			AccountId: mapping.AccountId.ValueString(),
+			UserId:    mapping.UserId.ValueString(),
+		})
+	}
+	err = r.client.UpdateOrgUserAccountMappings(ctx, mappings)
+	if err != nil {
+		return fmt.Errorf("uploading user account mappings: %w", err)
+	}
+
+	return nil
+}
+
+// orgTeamsToModel converts the teams of devlake to the schema, empty values
+// are mapped to null as they are the same in the csv file. The sort index is
+// always written, so it is kept as is.
+func orgTeamsToModel(teams []client.OrgTeam) []orgTeamModel {
+	models := []orgTeamModel{}
+	for _, team := range teams {
+		models = append(models, orgTeamModel{
+			ID:        types.StringValue(team.ID),
+			Alias:     orgStringToModel(team.Alias),
+			Name:      types.StringValue(team.Name),
+			ParentId:  orgStringToModel(team.ParentId),
+			SortIndex: types.Int64Value(int64(team.SortIndex)),
+		})
+	}
+	return models
+}
+
+// orgUsersToModel converts the users of devlake to the schema, empty values
+// are mapped to null as they are the same in the csv file.
+func orgUsersToModel(users []client.OrgUser) []orgUserModel {
+	models := []orgUserModel{}
+	for _, user := range users {
+		var teamIds []types.String
+		for _, teamId := range user.TeamIds {
+			teamIds = append(teamIds, types.StringValue(teamId))
+		}
+		models = append(models, orgUserModel{
+			ID:      types.StringValue(user.ID),
+			Email:   orgStringToModel(user.Email),
+			Name:    types.StringValue(user.Name),
+			TeamIds: teamIds,
+		})
+	}
+	return models
+}
+
+// orgUserAccountMappingsToModel converts the user account mappings of devlake
+// to the schema.
+func orgUserAccountMappingsToModel(mappings []client.OrgUserAccountMapping) []orgUserAccountMappingModel {
+	models := []orgUserAccountMappingModel{}
+	for _, mapping := range mappings {
+		models = append(models, orgUserAccountMappingModel{
+			AccountId: types.StringValue(mapping.AccountId),
+			UserId:    types.StringValue(mapping.UserId),
+		})
+	}
+	return models
+}
+
+// orgStringToModel maps an empty csv value to null.
+func orgStringToModel(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	orgConfig = providerConfig + `
+resource "devlake_org" "org" {
+  teams = [
+    {
+      id   = "1"
+      name = "should_not_exist"
+    },
+    {
+      id         = "2"
+      alias      = "sub"
+      name       = "should_not_exist_sub"
+      parent_id  = "1"
+      sort_index = 1
+    },
+  ]
+  users = [
+    {
+      id       = "1"
+      email    = "jane@example.com"
+      name     = "Jane"
+      team_ids = ["1", "2"]
+    },
+  ]
+  user_account_mappings = [
+    {
+      account_id = "github:GithubAccount:1:123"
+      user_id    = "1"
+    },
+  ]
+}
+`
+)
+
+func TestAccOrgResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: orgConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_org.org", "id", "org"),
+					resource.TestCheckResourceAttr("devlake_org.org", "teams.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_org.org", "teams.*", map[string]string{
+						"id":         "1",
+						"name":       "should_not_exist",
+						"sort_index": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_org.org", "teams.*", map[string]string{
+						"id":         "2",
+						"alias":      "sub",
+						"name":       "should_not_exist_sub",
+						"parent_id":  "1",
+						"sort_index": "1",
+					}),
+					resource.TestCheckResourceAttr("devlake_org.org", "users.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_org.org", "users.*", map[string]string{
+						"id":         "1",
+						"email":      "jane@example.com",
+						"name":       "Jane",
+						"team_ids.#": "2",
+					}),
+					resource.TestCheckResourceAttr("devlake_org.org", "user_account_mappings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("devlake_org.org", "user_account_mappings.*", map[string]string{
+						"account_id": "github:GithubAccount:1:123",
+						"user_id":    "1",
+					}),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_org.org", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devlake_org.org",
+				ImportState:       true,
+				ImportStateId:     "org",
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Refresh after import testing, the imported state has to match
+			// the configuration without any changes.
+			{
+				ResourceName:       "devlake_org.org",
+				ImportState:        true,
+				ImportStateId:      "org",
+				ImportStatePersist: true,
+			},
+			{
+				Config:   orgConfig,
+				PlanOnly: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_org" "org" {
+  teams = [
+    {
+      id   = "1"
+      name = "should_not_exist"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_org.org", "id", "org"),
+					resource.TestCheckResourceAttr("devlake_org.org", "teams.#", "1"),
+					resource.TestCheckResourceAttr("devlake_org.org", "users.#", "0"),
+					resource.TestCheckResourceAttr("devlake_org.org", "user_account_mappings.#", "0"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_org.org", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	pagerDutyConnectionConfig = providerConfig + `
+resource "devlake_pagerduty_connection" "pd" {
+  name     = "should_not_exist"
+  token    = "whatever"
+}
+`
+)
+
+func TestAccPagerDutyConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: pagerDutyConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "endpoint", "https://api.pagerduty.com/"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "rate_limit_per_hour", "0"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "id"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_pagerduty_connection.pd",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_pagerduty_connection.pd"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_pagerduty_connection.pd not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"token", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_pagerduty_connection" "pd" {
+  name     = "should_not_exist2"
+  token    = "whatever"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "endpoint", "https://api.pagerduty.com/"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "name", "should_not_exist2"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection.pd", "rate_limit_per_hour", "0"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "id"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection.pd", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
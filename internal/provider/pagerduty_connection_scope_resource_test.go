@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	pagerDutyConnectionScopeConfig = pagerDutyConnectionScopeConfigConfig + `
+resource "devlake_pagerduty_connection_scope" "scope" {
+  id = "PIJ90N7"
+  connection_id	= devlake_pagerduty_connection.pd.id
+  name = "devlake"
+  scope_config_id = devlake_pagerduty_connection_scopeconfig.scopeconf.id
+}
+`
+)
+
+func TestAccPagerDutyConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: pagerDutyConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection_scope.scope", "id", "PIJ90N7"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection_scope.scope", "name", "devlake"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection_scope.scope", "url", ""),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_pagerduty_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_pagerduty_connection.pd"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_pagerduty_connection.pd not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_pagerduty_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_pagerduty_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "scope_config_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: pagerDutyConnectionScopeConfigConfig + `
+resource "devlake_pagerduty_connection_scope" "scope" {
+  id = "PIJ90N7"
+  connection_id	= devlake_pagerduty_connection.pd.id
+  name = "devlake-prod"
+  scope_config_id = devlake_pagerduty_connection_scopeconfig.scopeconf.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection_scope.scope", "id", "PIJ90N7"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection_scope.scope", "name", "devlake-prod"),
+					resource.TestCheckResourceAttr("devlake_pagerduty_connection_scope.scope", "url", ""),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_pagerduty_connection_scope.scope", "scope_config_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
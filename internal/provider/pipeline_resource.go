@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pipelineResource{}
+	_ resource.ResourceWithConfigure   = &pipelineResource{}
+	_ resource.ResourceWithImportState = &pipelineResource{}
+)
+
+// pipelinePollInterval is the interval the status of a pipeline is polled
+// with while waiting for it to finish.
+const pipelinePollInterval = 10 * time.Second
+
+// NewPipelineResource is a helper function to simplify the provider implementation.
+func NewPipelineResource() resource.Resource {
+	return &pipelineResource{}
+}
+
+// pipelineResource is the resource implementation.
+type pipelineResource struct {
+	client *client.Client
+}
+
+// pipelineResourceModel maps the resource schema data.
+type pipelineResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	BeganAt        types.String   `tfsdk:"began_at"`
+	BlueprintId    types.String   `tfsdk:"blueprint_id"`
+	FinishedAt     types.String   `tfsdk:"finished_at"`
+	FinishedTasks  types.Int64    `tfsdk:"finished_tasks"`
+	FullSync       types.Bool     `tfsdk:"full_sync"`
+	Message        types.String   `tfsdk:"message"`
+	SkipCollectors types.Bool     `tfsdk:"skip_collectors"`
+	Status         types.String   `tfsdk:"status"`
+	TotalTasks     types.Int64    `tfsdk:"total_tasks"`
+	Triggers       types.Map      `tfsdk:"triggers"`
+	Wait           types.Bool     `tfsdk:"wait"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *pipelineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+// Schema defines the schema for the resource.
+func (r *pipelineResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a pipeline run of a blueprint, e.g. to collect the data of newly added scopes right away. A new pipeline is triggered whenever the blueprint, the options or the triggers change. Destroying the resource only removes it from the state, the pipeline stays in the devlake history.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier of the pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the pipeline.",
+			},
+			"began_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When devlake started running the pipeline.",
+			},
+			"blueprint_id": schema.StringAttribute{
+				Description: "The blueprint to trigger the pipeline for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"finished_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the pipeline finished.",
+			},
+			"finished_tasks": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of finished tasks of the pipeline.",
+			},
+			"full_sync": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether all data is collected again instead of only the data changed since the last run. Defaults to 'false'.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error message of a failed pipeline.",
+			},
+			"skip_collectors": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether only the already collected data is transformed again, without collecting new data. Defaults to 'false'.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the pipeline, e.g. 'TASK_RUNNING', 'TASK_COMPLETED' or 'TASK_FAILED'.",
+			},
+			"total_tasks": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of tasks of the pipeline.",
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which trigger a new pipeline when changed, e.g. the ids of the scopes of the blueprint.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to wait until the pipeline finished, up to the create timeout. A failed pipeline fails the apply with the messages of the failed tasks. Defaults to 'false'.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *pipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan pipelineResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Trigger new pipeline
+	pipeline, err := r.client.TriggerBlueprint(ctx, plan.BlueprintId.ValueString(), client.BlueprintTrigger{
+		FullSync:       plan.FullSync.ValueBool(),
+		SkipCollectors: plan.SkipCollectors.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake pipeline",
+			"Could not trigger devlake pipeline for blueprint "+plan.BlueprintId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if plan.Wait.ValueBool() {
+		pipeline, err = r.waitForPipeline(ctx, pipeline)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for devlake pipeline",
+				"Could not wait for devlake pipeline "+strconv.Itoa(pipeline.ID)+" to finish, unexpected error: "+err.Error(),
+			)
+		} else {
+			resp.Diagnostics.Append(r.pipelineTaskDiagnostics(ctx, pipeline)...)
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values,
+	// the state is also saved for failed pipelines so they are tainted.
+	pipelineToModel(pipeline, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *pipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state pipelineResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed pipeline value from Devlake
+	pipeline, err := r.client.ReadPipeline(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// triggered again on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake pipeline",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite pipeline with refreshed state
+	pipelineToModel(pipeline, &state)
+	if state.Wait.IsNull() {
+		state.Wait = types.BoolValue(false)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only refreshes the pipeline, all attributes which would trigger a
+// new pipeline require a replacement.
+func (r *pipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan pipelineResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	pipeline, err := r.client.ReadPipeline(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake pipeline",
+			"Could not read devlake pipeline, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	pipelineToModel(pipeline, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the Terraform state, the pipeline is kept in devlake.
+func (r *pipelineResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *pipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForPipeline polls the pipeline until devlake finished running it or
+// the context is done. The last read pipeline is also returned on errors.
+func (r *pipelineResource) waitForPipeline(ctx context.Context, pipeline *client.Pipeline) (*client.Pipeline, error) {
+	ticker := time.NewTicker(pipelinePollInterval)
+	defer ticker.Stop()
+
+	for !pipelineFinished(pipeline.Status) {
+		select {
+		case <-ctx.Done():
+			return pipeline, fmt.Errorf("last status %s: %w", pipeline.Status, ctx.Err())
+		case <-ticker.C:
+		}
+
+		refreshedPipeline, err := r.client.ReadPipeline(ctx, strconv.Itoa(pipeline.ID))
+		if err != nil {
+			return pipeline, err
+		}
+		pipeline = refreshedPipeline
+	}
+	return pipeline, nil
+}
+
+// pipelineTaskDiagnostics returns an error for each failed task of a failed
+// pipeline, or warnings if the pipeline skipped the failed tasks.
+func (r *pipelineResource) pipelineTaskDiagnostics(ctx context.Context, pipeline *client.Pipeline) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if pipeline.Status == "TASK_COMPLETED" {
+		return diags
+	}
+
+	summary := "Devlake pipeline " + strconv.Itoa(pipeline.ID) + " failed"
+	addDiagnostic := diags.AddError
+	switch pipeline.Status {
+	case "TASK_CANCELLED":
+		summary = "Devlake pipeline " + strconv.Itoa(pipeline.ID) + " was cancelled"
+	case "TASK_PARTIAL":
+		summary = "Devlake pipeline " + strconv.Itoa(pipeline.ID) + " partially failed"
+		addDiagnostic = diags.AddWarning
+	}
+
+	tasks, err := r.client.ListPipelineTasks(ctx, strconv.Itoa(pipeline.ID))
+	if err != nil {
+		diags.AddError(summary, pipeline.Message)
+		diags.AddWarning(
+			"Unable to read devlake pipeline tasks",
+			err.Error(),
+		)
+		return diags
+	}
+
+	failedTasks := 0
+	for _, task := range tasks {
+		if task.Status != "TASK_FAILED" {
+			continue
+		}
+		failedTasks++
+		detail := fmt.Sprintf("Task %d of plugin %s failed", task.ID, task.Plugin)
+		if task.FailedSubTask != "" {
+			detail += " in subtask " + task.FailedSubTask
+		}
+		addDiagnostic(summary, detail+": "+task.Message)
+	}
+	if failedTasks == 0 && pipeline.Status != "TASK_PARTIAL" {
+		diags.AddError(summary, pipeline.Message)
+	}
+	return diags
+}
+
+// pipelineFinished returns whether devlake stopped running a pipeline with
+// the given status.
+func pipelineFinished(status string) bool {
+	switch status {
+	case "TASK_COMPLETED", "TASK_FAILED", "TASK_CANCELLED", "TASK_PARTIAL":
+		return true
+	}
+	return false
+}
+
+// pipelineToModel maps a pipeline returned by devlake to the resource model.
+func pipelineToModel(pipeline *client.Pipeline, model *pipelineResourceModel) {
+	model.ID = types.StringValue(strconv.Itoa(pipeline.ID))
+	model.BeganAt = types.StringPointerValue(pipeline.BeganAt)
+	model.BlueprintId = types.StringValue(strconv.Itoa(pipeline.BlueprintId))
+	model.FinishedAt = types.StringPointerValue(pipeline.FinishedAt)
+	model.FinishedTasks = types.Int64Value(int64(pipeline.FinishedTasks))
+	model.FullSync = types.BoolValue(pipeline.FullSync)
+	model.Message = types.StringValue(pipeline.Message)
+	model.SkipCollectors = types.BoolValue(pipeline.SkipCollectors)
+	model.Status = types.StringValue(pipeline.Status)
+	model.TotalTasks = types.Int64Value(int64(pipeline.TotalTasks))
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pipelineResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPipelineResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: blueprintConfig + `
+resource "devlake_pipeline" "run" {
+  blueprint_id    = devlake_blueprint.bp.id
+  skip_collectors = true
+  triggers = {
+    scope_ids = devlake_github_connection_scope.scope.id
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("devlake_pipeline.run", "blueprint_id", "devlake_blueprint.bp", "id"),
+					resource.TestCheckResourceAttr("devlake_pipeline.run", "full_sync", "false"),
+					resource.TestCheckResourceAttr("devlake_pipeline.run", "skip_collectors", "true"),
+					resource.TestCheckResourceAttr("devlake_pipeline.run", "triggers.scope_ids", "42"),
+					resource.TestCheckResourceAttr("devlake_pipeline.run", "wait", "false"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_pipeline.run", "id"),
+					resource.TestCheckResourceAttrSet("devlake_pipeline.run", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_pipeline.run", "status"),
+					resource.TestCheckResourceAttrSet("devlake_pipeline.run", "total_tasks"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devlake_pipeline.run",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here. The triggers only exist in
+				// terraform and the status changes while the pipeline runs.
+				ImportStateVerifyIgnore: []string{"last_updated", "triggers", "began_at", "finished_at", "finished_tasks", "message", "status"},
+			},
+			// Changing the triggers runs a new pipeline
+			{
+				Config: blueprintConfig + `
+resource "devlake_pipeline" "run" {
+  blueprint_id    = devlake_blueprint.bp.id
+  skip_collectors = true
+  triggers = {
+    scope_ids = "${devlake_github_connection_scope.scope.id},43"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_pipeline.run", "triggers.scope_ids", "42,43"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_pipeline.run", "id"),
+					resource.TestCheckResourceAttrSet("devlake_pipeline.run", "status"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
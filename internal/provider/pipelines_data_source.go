@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pipelinesDataSource{}
+	_ datasource.DataSourceWithConfigure = &pipelinesDataSource{}
+)
+
+// pipelinesDefaultPageSize is the page size used without a configured
+// page_size.
+const pipelinesDefaultPageSize = 50
+
+// NewPipelinesDataSource is a helper function to simplify the provider implementation.
+func NewPipelinesDataSource() datasource.DataSource {
+	return &pipelinesDataSource{}
+}
+
+// pipelinesDataSource is the data source implementation.
+type pipelinesDataSource struct {
+	client *client.Client
+}
+
+// pipelinesDataSourceModel maps the data source schema data.
+type pipelinesDataSourceModel struct {
+	BlueprintId types.String              `tfsdk:"blueprint_id"`
+	Page        types.Int64               `tfsdk:"page"`
+	PageSize    types.Int64               `tfsdk:"page_size"`
+	Pipelines   []pipelineDataSourceModel `tfsdk:"pipelines"`
+	Status      types.String              `tfsdk:"status"`
+	TotalCount  types.Int64               `tfsdk:"total_count"`
+}
+
+// pipelineDataSourceModel maps the pipeline schema data.
+type pipelineDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	BeganAt       types.String `tfsdk:"began_at"`
+	BlueprintId   types.String `tfsdk:"blueprint_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	ErrorName     types.String `tfsdk:"error_name"`
+	FinishedAt    types.String `tfsdk:"finished_at"`
+	FinishedTasks types.Int64  `tfsdk:"finished_tasks"`
+	Message       types.String `tfsdk:"message"`
+	Status        types.String `tfsdk:"status"`
+	TotalTasks    types.Int64  `tfsdk:"total_tasks"`
+}
+
+// Metadata returns the data source type name.
+func (d *pipelinesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines"
+}
+
+// Schema defines the schema for the data source.
+func (d *pipelinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the most recent pipelines, optionally of a single blueprint or with a given status, e.g. to check in terraform that the last pipeline of a blueprint succeeded.",
+		Attributes: map[string]schema.Attribute{
+			"blueprint_id": schema.StringAttribute{
+				Description: "Only list the pipelines of this blueprint.",
+				Optional:    true,
+			},
+			"page": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The page of pipelines to list, starting at 1. Defaults to '1'.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The number of pipelines per page. Defaults to '%d'.", pipelinesDefaultPageSize),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"pipelines": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The pipelines of the page, the most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Numeric identifier of the pipeline.",
+						},
+						"began_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When devlake started running the pipeline, null if it did not start yet.",
+						},
+						"blueprint_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The blueprint the pipeline was run for.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the pipeline was created in devlake.",
+						},
+						"error_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the error of a failed pipeline.",
+						},
+						"finished_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the pipeline finished, null if it is still running.",
+						},
+						"finished_tasks": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of finished tasks of the pipeline.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "The error message of a failed pipeline.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the pipeline, e.g. 'TASK_RUNNING', 'TASK_COMPLETED' or 'TASK_FAILED'.",
+						},
+						"total_tasks": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of tasks of the pipeline.",
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Only list the pipelines with this status.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("TASK_CREATED", "TASK_RERUN", "TASK_RESUME", "TASK_RUNNING", "TASK_COMPLETED", "TASK_FAILED", "TASK_CANCELLED", "TASK_PARTIAL"),
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total number of pipelines matching blueprint_id and status, across all pages.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pipelinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pipelinesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Page.IsNull() {
+		state.Page = types.Int64Value(1)
+	}
+	if state.PageSize.IsNull() {
+		state.PageSize = types.Int64Value(pipelinesDefaultPageSize)
+	}
+
+	pipelines, count, err := d.client.ListPipelines(ctx, client.PipelineQuery{
+		BlueprintId: state.BlueprintId.ValueString(),
+		Page:        int(state.Page.ValueInt64()),
+		PageSize:    int(state.PageSize.ValueInt64()),
+		Status:      state.Status.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake pipelines",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.TotalCount = types.Int64Value(int64(count))
+	state.Pipelines = []pipelineDataSourceModel{}
+	for _, pipeline := range pipelines {
+		state.Pipelines = append(state.Pipelines, pipelineDataSourceModel{
+			ID:            types.StringValue(strconv.Itoa(pipeline.ID)),
+			BeganAt:       types.StringPointerValue(pipeline.BeganAt),
+			BlueprintId:   types.StringValue(strconv.Itoa(pipeline.BlueprintId)),
+			CreatedAt:     types.StringValue(pipeline.CreatedAt),
+			ErrorName:     types.StringValue(pipeline.ErrorName),
+			FinishedAt:    types.StringPointerValue(pipeline.FinishedAt),
+			FinishedTasks: types.Int64Value(int64(pipeline.FinishedTasks)),
+			Message:       types.StringValue(pipeline.Message),
+			Status:        types.StringValue(pipeline.Status),
+			TotalTasks:    types.Int64Value(int64(pipeline.TotalTasks)),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pipelinesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
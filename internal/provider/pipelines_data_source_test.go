@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPipelinesDataSource(t *testing.T) {
+	pipelineConfig := blueprintConfig + `
+resource "devlake_pipeline" "run" {
+  blueprint_id    = devlake_blueprint.bp.id
+  skip_collectors = true
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Trigger the pipeline first, data sources are read before
+			// dependent resources are created otherwise.
+			{
+				Config: pipelineConfig,
+			},
+			// Read testing
+			{
+				Config: pipelineConfig + `
+data "devlake_pipelines" "test" {
+  blueprint_id = devlake_blueprint.bp.id
+  page_size    = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devlake_pipelines.test", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.devlake_pipelines.test", "page", "1"),
+					resource.TestCheckResourceAttr("data.devlake_pipelines.test", "pipelines.#", "1"),
+					resource.TestCheckResourceAttrPair("data.devlake_pipelines.test", "pipelines.0.id", "devlake_pipeline.run", "id"),
+					resource.TestCheckResourceAttrPair("data.devlake_pipelines.test", "pipelines.0.blueprint_id", "devlake_blueprint.bp", "id"),
+					resource.TestCheckResourceAttrSet("data.devlake_pipelines.test", "pipelines.0.status"),
+				),
+			},
+			// Validation testing
+			{
+				Config:      `data "devlake_pipelines" "test" { status = "DONE" }`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectResource{}
+	_ resource.ResourceWithConfigure   = &projectResource{}
+	_ resource.ResourceWithImportState = &projectResource{}
+)
+
+// NewProjectResource is a helper function to simplify the provider implementation.
+func NewProjectResource() resource.Resource {
+	return &projectResource{}
+}
+
+// projectResource is the resource implementation.
+type projectResource struct {
+	client *client.Client
+}
+
+// projectResourceModel maps the resource schema data.
+type projectResourceModel struct {
+	ID          types.String                  `tfsdk:"id"`
+	LastUpdated types.String                  `tfsdk:"last_updated"`
+	BlueprintId types.String                  `tfsdk:"blueprint_id"`
+	CreatedAt   types.String                  `tfsdk:"created_at"`
+	Description types.String                  `tfsdk:"description"`
+	Metrics     map[string]projectMetricModel `tfsdk:"metrics"`
+	Name        types.String                  `tfsdk:"name"`
+	UpdatedAt   types.String                  `tfsdk:"updated_at"`
+	Timeouts    timeouts.Value                `tfsdk:"timeouts"`
+}
+
+// projectMetricAttrTypes are the attribute types of a metric plugin of a
+// project.
+var projectMetricAttrTypes = map[string]attr.Type{
+	"enable":        types.BoolType,
+	"plugin_option": jsontypes.NormalizedType{},
+}
+
+// projectMetricModel maps the metric plugin schema data of a project.
+type projectMetricModel struct {
+	Enable       types.Bool           `tfsdk:"enable"`
+	PluginOption jsontypes.Normalized `tfsdk:"plugin_option"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for the project, this is the name of the project as devlake uses it as primary key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the project.",
+			},
+			"blueprint_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the blueprint devlake created along with the project. Can be used to import it as 'devlake_blueprint'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the project was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A description for the project.",
+				Optional:    true,
+			},
+			"metrics": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The metric plugins of the project keyed by the plugin name, one of 'dora', 'linker' or 'issue_trace'. Defaults to the DORA metrics being enabled, disable them instead of removing all plugins.",
+				Optional:    true,
+				Default: mapdefault.StaticValue(types.MapValueMust(
+					types.ObjectType{AttrTypes: projectMetricAttrTypes},
+					map[string]attr.Value{
+						"dora": types.ObjectValueMust(
+							projectMetricAttrTypes,
+							map[string]attr.Value{
+								"enable":        types.BoolValue(true),
+								"plugin_option": jsontypes.NewNormalizedValue("{}"),
+							},
+						),
+					},
+				)),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enable": schema.BoolAttribute{
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Whether the metric plugin is enabled for the project. Defaults to 'true'.",
+							Optional:    true,
+						},
+						"plugin_option": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Default:     stringdefault.StaticString("{}"),
+							Description: "The options of the metric plugin as json, e.g. '{\"prToIssueRegexp\": \"...\"}' for the linker. Defaults to '{}'.",
+							Optional:    true,
+						},
+					},
+				},
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf("dora", "linker", "issue_trace")),
+					// devlake ignores an empty list of metrics and keeps
+					// the previous ones
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the project. Changing the name creates a new project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the project was updated in devlake.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	var projectCreate = client.Project{
+		Description: plan.Description.ValueString(),
+		Metrics:     projectMetricsFromModel(plan.Metrics),
+		Name:        plan.Name.ValueString(),
+	}
+
+	// Create new project
+	project, err := r.client.CreateProject(ctx, projectCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake project",
+			"Could not create devlake project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(project.Name)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.BlueprintId = projectBlueprintIdToModel(project.Blueprint)
+	plan.CreatedAt = types.StringValue(project.CreatedAt)
+	plan.Description = types.StringValue(project.Description)
+	plan.Metrics = projectMetricsToModel(project.Metrics)
+	plan.Name = types.StringValue(project.Name)
+	plan.UpdatedAt = types.StringValue(project.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed project value from Devlake
+	project, err := r.client.ReadProject(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake project",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite project with refreshed state
+	state.ID = types.StringValue(project.Name)
+	state.BlueprintId = projectBlueprintIdToModel(project.Blueprint)
+	state.CreatedAt = types.StringValue(project.CreatedAt)
+	state.Description = types.StringValue(project.Description)
+	state.Metrics = projectMetricsToModel(project.Metrics)
+	state.Name = types.StringValue(project.Name)
+	state.UpdatedAt = types.StringValue(project.UpdatedAt)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan projectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	var projectUpdate = client.Project{
+		Description: plan.Description.ValueString(),
+		Metrics:     projectMetricsFromModel(plan.Metrics),
+		Name:        plan.Name.ValueString(),
+	}
+
+	// Update existing project
+	updatedProject, err := r.client.UpdateProject(ctx, plan.ID.ValueString(), projectUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake project",
+			"Could not update devlake project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(updatedProject.Name)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.BlueprintId = projectBlueprintIdToModel(updatedProject.Blueprint)
+	plan.CreatedAt = types.StringValue(updatedProject.CreatedAt)
+	plan.Description = types.StringValue(updatedProject.Description)
+	plan.Metrics = projectMetricsToModel(updatedProject.Metrics)
+	plan.Name = types.StringValue(updatedProject.Name)
+	plan.UpdatedAt = types.StringValue(updatedProject.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state projectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing project
+	err := r.client.DeleteProject(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake project",
+			"Could not delete devlake project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// projectMetricsFromModel converts the metrics map of the schema to the list
+// the devlake api expects, sorted by plugin name for stable requests.
+func projectMetricsFromModel(metrics map[string]projectMetricModel) []client.ProjectMetric {
+	pluginNames := make([]string, 0, len(metrics))
+	for pluginName := range metrics {
+		pluginNames = append(pluginNames, pluginName)
+	}
+	sort.Strings(pluginNames)
+
+	projectMetrics := []client.ProjectMetric{}
+	for _, pluginName := range pluginNames {
+		projectMetric := client.ProjectMetric{
+			Enable:     metrics[pluginName].Enable.ValueBool(),
+			PluginName: pluginName,
+		}
+		if isKnown(metrics[pluginName].PluginOption) {
+			projectMetric.PluginOption = json.RawMessage(metrics[pluginName].PluginOption.ValueString())
+		}
+		projectMetrics = append(projectMetrics, projectMetric)
+	}
+	return projectMetrics
+}
+
+// projectMetricsToModel converts the metrics list of the devlake api to the
+// map used in the schema. Missing plugin options are mapped to an empty json
+// object.
+func projectMetricsToModel(projectMetrics []client.ProjectMetric) map[string]projectMetricModel {
+	metrics := map[string]projectMetricModel{}
+	for _, projectMetric := range projectMetrics {
+		pluginOption := "{}"
+		if len(projectMetric.PluginOption) > 0 && string(projectMetric.PluginOption) != "null" {
+			pluginOption = string(projectMetric.PluginOption)
+		}
+		metrics[projectMetric.PluginName] = projectMetricModel{
+			Enable:       types.BoolValue(projectMetric.Enable),
+			PluginOption: jsontypes.NewNormalizedValue(pluginOption),
+		}
+	}
+	return metrics
+}
+
+// projectBlueprintIdToModel returns the id of the blueprint of a project, it
+// is null if the project has no blueprint.
+func projectBlueprintIdToModel(blueprint *client.Blueprint) types.String {
+	if blueprint == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(strconv.Itoa(blueprint.ID))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	projectConfig = providerConfig + `
+resource "devlake_project" "project" {
+  name        = "should_not_exist"
+  description = "example project"
+}
+`
+)
+
+func TestAccProjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing, devlake ignores an empty list of metrics.
+			{
+				Config: providerConfig + `
+resource "devlake_project" "project" {
+  name    = "should_not_exist"
+  metrics = {}
+}
+`,
+				ExpectError: regexp.MustCompile(`map must contain at least 1 elements`),
+			},
+			// Create and Read testing
+			{
+				Config: projectConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_project.project", "description", "example project"),
+					resource.TestCheckResourceAttr("devlake_project.project", "id", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_project.project", "metrics.%", "1"),
+					resource.TestCheckResourceAttr("devlake_project.project", "metrics.dora.enable", "true"),
+					resource.TestCheckResourceAttr("devlake_project.project", "metrics.dora.plugin_option", "{}"),
+					resource.TestCheckResourceAttr("devlake_project.project", "name", "should_not_exist"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_project.project", "blueprint_id"),
+					resource.TestCheckResourceAttrSet("devlake_project.project", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_project.project", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_project.project", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devlake_project.project",
+				ImportState:       true,
+				ImportStateId:     "should_not_exist",
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_project" "project" {
+  name        = "should_not_exist"
+  description = "example project desc"
+  metrics = {
+    dora = {
+      enable = false
+    }
+    linker = {
+      plugin_option = jsonencode({
+        prToIssueRegexp = "(?mi)(Closes)[\\s]*.*(((and )?#\\d+[ ]*)+)"
+      })
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_project.project", "description", "example project desc"),
+					resource.TestCheckResourceAttr("devlake_project.project", "id", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_project.project", "metrics.%", "2"),
+					resource.TestCheckResourceAttr("devlake_project.project", "metrics.dora.enable", "false"),
+					resource.TestCheckResourceAttr("devlake_project.project", "metrics.dora.plugin_option", "{}"),
+					resource.TestCheckResourceAttr("devlake_project.project", "metrics.linker.enable", "true"),
+					resource.TestCheckResourceAttrSet("devlake_project.project", "metrics.linker.plugin_option"),
+					resource.TestCheckResourceAttr("devlake_project.project", "name", "should_not_exist"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_project.project", "blueprint_id"),
+					resource.TestCheckResourceAttrSet("devlake_project.project", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_project.project", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_project.project", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -4,13 +4,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"terraform-provider-devlake/internal/client"
@@ -18,9 +28,14 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &devlakeProvider{}
+	_ provider.Provider                   = &devlakeProvider{}
+	_ provider.ProviderWithValidateConfig = &devlakeProvider{}
 )
 
+// defaultTimeout is used for resource operations without a configured
+// timeouts block.
+const defaultTimeout = 20 * time.Minute
+
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -40,8 +55,30 @@ type devlakeProvider struct {
 
 // devlakeProviderModel maps provider schema data to a Go type.
 type devlakeProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Auth               *devlakeProviderAuthModel `tfsdk:"auth"`
+	CACertFile         types.String              `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String              `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String              `tfsdk:"client_cert_file"`
+	ClientCertPEM      types.String              `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String              `tfsdk:"client_key_file"`
+	ClientKeyPEM       types.String              `tfsdk:"client_key_pem"`
+	Headers            types.Map                 `tfsdk:"headers"`
+	Host               types.String              `tfsdk:"host"`
+	InsecureSkipVerify types.Bool                `tfsdk:"insecure_skip_verify"`
+	MaxRetries         types.Int64               `tfsdk:"max_retries"`
+	ProxyURL           types.String              `tfsdk:"proxy_url"`
+	RequestTimeout     timetypes.GoDuration      `tfsdk:"request_timeout"`
+	RetryWaitMax       timetypes.GoDuration      `tfsdk:"retry_wait_max"`
+	RetryWaitMin       timetypes.GoDuration      `tfsdk:"retry_wait_min"`
+	Token              types.String              `tfsdk:"token"`
+}
+
+// devlakeProviderAuthModel maps the auth block of the provider schema.
+type devlakeProviderAuthModel struct {
+	ApiKey   types.String `tfsdk:"api_key"`
+	None     types.Bool   `tfsdk:"none"`
+	Password types.String `tfsdk:"password"`
+	Username types.String `tfsdk:"username"`
 }
 
 // Metadata returns the provider type name.
@@ -54,19 +91,176 @@ func (p *devlakeProvider) Metadata(_ context.Context, _ provider.MetadataRequest
 func (p *devlakeProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded certificate authority bundle trusted in addition to the system certificates, e.g. for an internal CA. May also be provided via DEVLAKE_CA_CERT_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded certificate authority bundle trusted in addition to the system certificates, e.g. for an internal CA. May also be provided via DEVLAKE_CA_CERT_PEM environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded client certificate for mutual TLS, requires a client key. May also be provided via DEVLAKE_CLIENT_CERT_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate for mutual TLS, requires a client key. May also be provided via DEVLAKE_CLIENT_CERT_PEM environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded private key of the client certificate. May also be provided via DEVLAKE_CLIENT_KEY_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate. May also be provided via DEVLAKE_CLIENT_KEY_PEM environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_file")),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional http headers sent with every request, e.g. for an authenticating proxy in front of devlake. They can not replace the Accept header and the credentials of the configured authentication. May also be provided via DEVLAKE_HEADERS environment variable as comma separated list of name=value pairs.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"host": schema.StringAttribute{
 				Optional:    true,
 				Description: "URI for Devlake API. May also be provided via DEVLAKE_HOST environment variable.",
 			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the devlake server certificate. Only use this for testing. May also be provided via DEVLAKE_INSECURE_SKIP_VERIFY environment variable.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How often a failed request is retried, 0 disables retries. Requests are retried when devlake is rate limiting and, unless they are POST or PATCH requests, on connection errors and when devlake is unavailable (502, 503, 504). Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the http proxy requests are sent through. May also be provided via DEVLAKE_PROXY_URL environment variable, the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used otherwise.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Description: "Timeout of a single request to the devlake api as go duration, e.g. '30s'. Defaults to '10s'.",
+				Optional:    true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Description: "Maximum time to wait between retries as go duration, also caps the wait time requested by a Retry-After header. Defaults to '30s'.",
+				Optional:    true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Description: "Minimum time to wait between retries as go duration, it is doubled with every retry. Defaults to '1s'.",
+				Optional:    true,
+			},
 			"token": schema.StringAttribute{
-				Description: "Token for Devlake API. May also be provided via DEVLAKE_TOKEN environment variable.",
+				Description: "Api key for Devlake API, sent as Bearer token. Shorthand for the api_key of the auth block. May also be provided via DEVLAKE_TOKEN environment variable.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("auth")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth": schema.SingleNestedBlock{
+				Description: "How to authenticate against the devlake api, exactly one of api_key, username and password, or none must be set. Without this block the token is used, or the DEVLAKE_USERNAME and DEVLAKE_PASSWORD environment variables for basic auth.",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Description: "Api key sent as Bearer token, e.g. one created with the devlake_apikey resource.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"none": schema.BoolAttribute{
+						Description: "Send requests without authentication, e.g. to a local devlake started with docker compose.",
+						Optional:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Password for http basic auth, e.g. of the nginx in front of the devlake config-ui.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"username": schema.StringAttribute{
+						Description: "Username for http basic auth, e.g. of the nginx in front of the devlake config-ui.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+// ValidateConfig ensures exactly one authentication method is chosen in the
+// auth block.
+func (p *devlakeProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var auth *devlakeProviderAuthModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth"), &auth)...)
+	if resp.Diagnostics.HasError() || auth == nil {
+		return
+	}
+
+	methods := 0
+	if !auth.ApiKey.IsNull() {
+		methods++
+	}
+	if !auth.Username.IsNull() || !auth.Password.IsNull() {
+		methods++
+	}
+	if auth.None.IsUnknown() || auth.None.ValueBool() {
+		methods++
+	}
+	if methods != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth"),
+			"Invalid devlake api authentication",
+			"Exactly one of api_key, username and password, or none = true must be set in the auth block.",
+		)
+		return
+	}
+
+	if auth.Username.IsNull() && !auth.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth").AtName("username"),
+			"Missing devlake api username",
+			"Basic authentication requires both username and password.",
+		)
+	}
+	if !auth.Username.IsNull() && auth.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth").AtName("password"),
+			"Missing devlake api password",
+			"Basic authentication requires both username and password.",
+		)
+	}
+}
+
 // Configure prepares a devlake API client for data sources and resources.
 func (p *devlakeProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring Devlake client")
@@ -100,6 +294,16 @@ func (p *devlakeProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.Auth != nil && (config.Auth.ApiKey.IsUnknown() || config.Auth.None.IsUnknown() ||
+		config.Auth.Password.IsUnknown() || config.Auth.Username.IsUnknown()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth"),
+			"Unknown devlake api authentication",
+			"The provider cannot create the devlake api client as there is an unknown configuration value in the auth block. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -131,16 +335,83 @@ func (p *devlakeProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
-	if token == "" {
+	// The auth block takes precedence over the token, basic auth is only
+	// configured via environment variables without either.
+	var auth client.Auth
+	switch {
+	case config.Auth != nil && !config.Auth.ApiKey.IsNull():
+		auth = client.BearerAuth{Token: config.Auth.ApiKey.ValueString()}
+	case config.Auth != nil && !config.Auth.Username.IsNull():
+		auth = client.BasicAuth{Username: config.Auth.Username.ValueString(), Password: config.Auth.Password.ValueString()}
+	case config.Auth != nil && config.Auth.None.ValueBool():
+		auth = client.NoAuth{}
+	case token != "":
+		auth = client.BearerAuth{Token: token}
+	case os.Getenv("DEVLAKE_USERNAME") != "":
+		auth = client.BasicAuth{Username: os.Getenv("DEVLAKE_USERNAME"), Password: os.Getenv("DEVLAKE_PASSWORD")}
+	}
+
+	if auth == nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
-			"Missing devlake api token",
+			"Missing devlake api authentication",
 			"The provider cannot create the devlake api client as there is a missing or empty value for the devlake api token. "+
-				"Set the token value in the configuration or use the DEVLAKE_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the token value or an auth block in the configuration, or use the DEVLAKE_TOKEN or the DEVLAKE_USERNAME and DEVLAKE_PASSWORD environment variables. "+
+				"Use an auth block with none = true for a devlake without authentication.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the transport from the optional TLS and proxy settings, each
+	// falling back to its environment variable.
+	transportConfig := client.TransportConfig{
+		CACertPEM:     pemFromConfigOrEnv(config.CACertPEM, config.CACertFile, "ca_cert", &resp.Diagnostics),
+		ClientCertPEM: pemFromConfigOrEnv(config.ClientCertPEM, config.ClientCertFile, "client_cert", &resp.Diagnostics),
+		ClientKeyPEM:  pemFromConfigOrEnv(config.ClientKeyPEM, config.ClientKeyFile, "client_key", &resp.Diagnostics),
+		ProxyURL:      os.Getenv("DEVLAKE_PROXY_URL"),
+	}
+	if isKnown(config.ProxyURL) {
+		transportConfig.ProxyURL = config.ProxyURL.ValueString()
+	}
+	if isKnown(config.InsecureSkipVerify) {
+		transportConfig.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if env := os.Getenv("DEVLAKE_INSECURE_SKIP_VERIFY"); env != "" {
+		insecureSkipVerify, err := strconv.ParseBool(env)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid DEVLAKE_INSECURE_SKIP_VERIFY environment variable",
+				"The DEVLAKE_INSECURE_SKIP_VERIFY environment variable must be a boolean: "+err.Error(),
+			)
+		}
+		transportConfig.InsecureSkipVerify = insecureSkipVerify
+	}
+
+	transport, err := client.NewTransport(transportConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid devlake api TLS or proxy configuration",
+			"The provider cannot create the devlake api client as the TLS or proxy configuration is invalid: "+err.Error(),
 		)
 	}
 
+	headers := map[string]string{}
+	if isKnown(config.Headers) {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+	} else if env := os.Getenv("DEVLAKE_HEADERS"); env != "" {
+		headers, err = parseHeaders(env)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers"),
+				"Invalid DEVLAKE_HEADERS environment variable",
+				"The DEVLAKE_HEADERS environment variable must be a comma separated list of name=value pairs: "+err.Error(),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -152,7 +423,7 @@ func (p *devlakeProvider) Configure(ctx context.Context, req provider.ConfigureR
 	tflog.Debug(ctx, "Creating Devlake client")
 
 	// Create a new Devlake client using the configuration values
-	client, err := client.NewClient(&host, &token)
+	client, err := client.NewClient(&host, auth)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create devlake api client",
@@ -163,6 +434,41 @@ func (p *devlakeProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	client.HTTPClient.Transport = transport
+	client.Headers = headers
+
+	// Apply the optional retry and timeout settings, unknown values keep the
+	// client defaults.
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		client.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		requestTimeout, diags := config.RequestTimeout.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		client.HTTPClient.Timeout = requestTimeout
+	}
+	if !config.RetryWaitMax.IsNull() && !config.RetryWaitMax.IsUnknown() {
+		retryWaitMax, diags := config.RetryWaitMax.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		client.RetryWaitMax = retryWaitMax
+	}
+	if !config.RetryWaitMin.IsNull() && !config.RetryWaitMin.IsUnknown() {
+		retryWaitMin, diags := config.RetryWaitMin.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		client.RetryWaitMin = retryWaitMin
+	}
+	if client.RetryWaitMin > client.RetryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid devlake api retry wait time",
+			"The minimum wait time between retries must not be greater than the maximum wait time of "+client.RetryWaitMax.String()+".",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Make the Devlake client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -171,10 +477,71 @@ func (p *devlakeProvider) Configure(ctx context.Context, req provider.ConfigureR
 	tflog.Info(ctx, "Configured Devlake client", map[string]any{"success": true})
 }
 
+// isKnown reports whether a configuration value was set to a known value.
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// pemFromConfigOrEnv returns the PEM content given inline or as file path by
+// the <name>_pem and <name>_file attributes, falling back to the
+// DEVLAKE_<NAME>_PEM and DEVLAKE_<NAME>_FILE environment variables.
+func pemFromConfigOrEnv(pemValue, fileValue types.String, name string, diags *diag.Diagnostics) []byte {
+	env := "DEVLAKE_" + strings.ToUpper(name)
+
+	var file string
+	switch {
+	case isKnown(pemValue):
+		return []byte(pemValue.ValueString())
+	case isKnown(fileValue):
+		file = fileValue.ValueString()
+	case os.Getenv(env+"_PEM") != "":
+		return []byte(os.Getenv(env + "_PEM"))
+	default:
+		file = os.Getenv(env + "_FILE")
+	}
+	if file == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(name+"_file"),
+			"Unable to read "+strings.ReplaceAll(name, "_", " ")+" file",
+			"The provider cannot create the devlake api client as the file could not be read: "+err.Error(),
+		)
+		return nil
+	}
+	return content
+}
+
+// parseHeaders parses a comma separated list of name=value pairs.
+func parseHeaders(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid header %q", pair)
+		}
+		headers[name] = strings.TrimSpace(value)
+	}
+	return headers, nil
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *devlakeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewApiKeysDataSource,
+		NewBitbucketServerConnectionDataSource,
+		NewBitbucketServerConnectionScopesDataSource,
+		NewBitbucketServerConnectionsDataSource,
+		NewGithubConnectionDataSource,
+		NewGithubConnectionScopesDataSource,
+		NewGithubConnectionsDataSource,
+		NewGithubRemoteScopesDataSource,
+		NewPipelinesDataSource,
+		NewSonarqubeRemoteScopesDataSource,
 	}
 }
 
@@ -182,11 +549,41 @@ func (p *devlakeProvider) DataSources(_ context.Context) []func() datasource.Dat
 func (p *devlakeProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewApiKeyResource,
+		NewAzureDevopsConnectionResource,
+		NewAzureDevopsConnectionScopeConfigResource,
+		NewAzureDevopsConnectionScopeResource,
+		NewBitbucketConnectionResource,
+		NewBitbucketConnectionScopeConfigResource,
+		NewBitbucketConnectionScopeResource,
 		NewBitbucketServerConnectionResource,
 		NewBitbucketServerConnectionScopeConfigResource,
 		NewBitbucketServerConnectionScopeResource,
+		NewBitbucketServerConnectionScopesResource,
+		NewBlueprintResource,
 		NewGithubConnectionResource,
 		NewGithubConnectionScopeConfigResource,
 		NewGithubConnectionScopeResource,
+		NewGithubConnectionScopesResource,
+		NewGitlabConnectionResource,
+		NewGitlabConnectionScopeConfigResource,
+		NewGitlabConnectionScopeResource,
+		NewJenkinsConnectionResource,
+		NewJenkinsConnectionScopeConfigResource,
+		NewJenkinsConnectionScopeResource,
+		NewJiraConnectionResource,
+		NewJiraConnectionScopeConfigResource,
+		NewJiraConnectionScopeResource,
+		NewOpsgenieConnectionResource,
+		NewOpsgenieConnectionScopeConfigResource,
+		NewOpsgenieConnectionScopeResource,
+		NewOrgResource,
+		NewPagerDutyConnectionResource,
+		NewPagerDutyConnectionScopeConfigResource,
+		NewPagerDutyConnectionScopeResource,
+		NewPipelineResource,
+		NewProjectResource,
+		NewSonarqubeConnectionResource,
+		NewSonarqubeConnectionScopeResource,
+		NewWebhookConnectionResource,
 	}
 }
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	sonarqubeConnectionConfig = providerConfig + `
+resource "devlake_sonarqube_connection" "sonar" {
+  endpoint = "https://sonar.example.com/api/"
+  name     = "should_not_exist"
+  token    = "whatever"
+}
+`
+)
+
+func TestAccSonarqubeConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: sonarqubeConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "endpoint", "https://sonar.example.com/api/"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "rate_limit_per_hour", "0"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "id"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_sonarqube_connection.sonar",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_sonarqube_connection.sonar"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_sonarqube_connection.sonar not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"token", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_sonarqube_connection" "sonar" {
+  endpoint = "https://sonar2.example.com/api/"
+  name     = "should_not_exist"
+  token    = "whatever"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "endpoint", "https://sonar2.example.com/api/"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "name", "should_not_exist"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "token", "whatever"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "proxy", ""),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection.sonar", "rate_limit_per_hour", "0"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "id"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection.sonar", "updated_at"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sonarqubeConnectionScopeResource{}
+	_ resource.ResourceWithConfigure   = &sonarqubeConnectionScopeResource{}
+	_ resource.ResourceWithImportState = &sonarqubeConnectionScopeResource{}
+)
+
+// NewSonarqubeConnectionScopeResource is a helper function to simplify the provider implementation.
+func NewSonarqubeConnectionScopeResource() resource.Resource {
+	return &sonarqubeConnectionScopeResource{}
+}
+
+// sonarqubeConnectionScopeResource is the resource implementation.
+type sonarqubeConnectionScopeResource struct {
+	client *client.Client
+}
+
+// sonarqubeConnectionScopeResourceModel maps the resource schema data.
+type sonarqubeConnectionScopeResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	ConnectionId types.String   `tfsdk:"connection_id"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	Name         types.String   `tfsdk:"name"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *sonarqubeConnectionScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sonarqube_connection_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *sonarqubeConnectionScopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the sonarqube project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection scope.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "The Connection this scope is part of.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scope was created in devlake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the sonarqube project.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *sonarqubeConnectionScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan sonarqubeConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	sonarqubeConnectionScopeCreate, err := sonarqubeConnectionScopeFromModel(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake sonarqube connection scope",
+			"Could not create devlake sonarqube connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	now := time.Now().Format(time.RFC3339)
+	sonarqubeConnectionScopeCreate.CreatedAt = now
+	sonarqubeConnectionScopeCreate.UpdatedAt = now
+
+	// Create new sonarqube connection scope
+	sonarqubeConnectionScope, err := r.client.CreateSonarqubeConnectionScope(ctx, plan.ConnectionId.ValueString(), *sonarqubeConnectionScopeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake sonarqube connection scope",
+			"Could not create devlake sonarqube connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	sonarqubeConnectionScopeToModel(sonarqubeConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sonarqubeConnectionScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state sonarqubeConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed sonarqube connection scope value from Devlake
+	sonarqubeConnectionScope, err := r.client.ReadSonarqubeConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake sonarqube connection scope",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection scope with refreshed state
+	sonarqubeConnectionScopeToModel(sonarqubeConnectionScope, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *sonarqubeConnectionScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan sonarqubeConnectionScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	sonarqubeConnectionScopeUpdate, err := sonarqubeConnectionScopeFromModel(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake sonarqube connection scope",
+			"Could not update devlake sonarqube connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	sonarqubeConnectionScopeUpdate.CreatedAt = plan.CreatedAt.ValueString()
+	sonarqubeConnectionScopeUpdate.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	// Update existing connection scope
+	updatedSonarqubeConnectionScope, err := r.client.UpdateSonarqubeConnectionScope(ctx, plan.ConnectionId.ValueString(), plan.ID.ValueString(), *sonarqubeConnectionScopeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake sonarqube connection scope",
+			"Could not update devlake sonarqube connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	sonarqubeConnectionScopeToModel(updatedSonarqubeConnectionScope, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sonarqubeConnectionScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state sonarqubeConnectionScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection scope
+	err := r.client.DeleteSonarqubeConnectionScope(ctx, state.ConnectionId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake sonarqube connection scope",
+			"Could not delete devlake sonarqube connection scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *sonarqubeConnectionScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and connection id and save to attribute
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,scope_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// sonarqubeConnectionScopeFromModel generates the API request body from the
+// plan.
+func sonarqubeConnectionScopeFromModel(plan sonarqubeConnectionScopeResourceModel) (*client.SonarqubeConnectionScope, error) {
+	connectionId, err := strconv.Atoi(plan.ConnectionId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.SonarqubeConnectionScope{
+		ConnectionId: connectionId,
+		Name:         plan.Name.ValueString(),
+		ProjectKey:   plan.ID.ValueString(),
+	}, nil
+}
+
+// sonarqubeConnectionScopeToModel maps a sonarqube connection scope returned
+// by devlake to the resource model.
+func sonarqubeConnectionScopeToModel(scope *client.SonarqubeConnectionScope, model *sonarqubeConnectionScopeResourceModel) {
+	model.ID = types.StringValue(scope.ProjectKey)
+	model.ConnectionId = types.StringValue(strconv.Itoa(scope.ConnectionId))
+	model.CreatedAt = types.StringValue(scope.CreatedAt)
+	model.Name = types.StringValue(scope.Name)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sonarqubeConnectionScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	sonarqubeConnectionScopeConfig = sonarqubeConnectionConfig + `
+resource "devlake_sonarqube_connection_scope" "scope" {
+  id = "devlake"
+  connection_id	= devlake_sonarqube_connection.sonar.id
+  name = "DevLake"
+}
+`
+)
+
+func TestAccSonarqubeConnectionScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: sonarqubeConnectionScopeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection_scope.scope", "id", "devlake"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection_scope.scope", "name", "DevLake"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection_scope.scope", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_sonarqube_connection_scope.scope",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var connectionId, scopeId string
+					if con, ok := s.RootModule().Resources["devlake_sonarqube_connection.sonar"]; ok {
+						connectionId = con.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_sonarqube_connection.sonar not found in state")
+					}
+					if scope, ok := s.RootModule().Resources["devlake_sonarqube_connection_scope.scope"]; ok {
+						scopeId = scope.Primary.ID
+					} else {
+						return "", fmt.Errorf("Resource devlake_sonarqube_connection_scope.scope not found in state")
+					}
+					return fmt.Sprintf("%s,%s", connectionId, scopeId), nil
+				},
+				ImportStateVerify: true,
+				// The last_updated attribute does exist in the devlake API, but
+				// we want the terraform state here
+				ImportStateVerifyIgnore: []string{"last_updated", "connection_id", "created_at"},
+			},
+			// Update and Read testing
+			{
+				Config: sonarqubeConnectionConfig + `
+resource "devlake_sonarqube_connection_scope" "scope" {
+  id = "devlake"
+  connection_id	= devlake_sonarqube_connection.sonar.id
+  name = "Apache DevLake"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection_scope.scope", "id", "devlake"),
+					resource.TestCheckResourceAttr("devlake_sonarqube_connection_scope.scope", "name", "Apache DevLake"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection_scope.scope", "connection_id"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection_scope.scope", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_sonarqube_connection_scope.scope", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
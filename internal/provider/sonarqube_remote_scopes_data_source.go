@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sonarqubeRemoteScopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &sonarqubeRemoteScopesDataSource{}
+)
+
+// NewSonarqubeRemoteScopesDataSource is a helper function to simplify the provider implementation.
+func NewSonarqubeRemoteScopesDataSource() datasource.DataSource {
+	return &sonarqubeRemoteScopesDataSource{}
+}
+
+// sonarqubeRemoteScopesDataSource is the data source implementation.
+type sonarqubeRemoteScopesDataSource struct {
+	client *client.Client
+}
+
+// sonarqubeRemoteScopesDataSourceModel maps the data source schema data.
+type sonarqubeRemoteScopesDataSourceModel struct {
+	ConnectionId types.String                `tfsdk:"connection_id"`
+	Scopes       []sonarqubeRemoteScopeModel `tfsdk:"scopes"`
+	Search       types.String                `tfsdk:"search"`
+}
+
+// sonarqubeRemoteScopeModel maps the project schema data.
+type sonarqubeRemoteScopeModel struct {
+	Name       types.String `tfsdk:"name"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Qualifier  types.String `tfsdk:"qualifier"`
+	Visibility types.String `tfsdk:"visibility"`
+}
+
+// Metadata returns the data source type name.
+func (d *sonarqubeRemoteScopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sonarqube_remote_scopes"
+}
+
+// Schema defines the schema for the data source.
+func (d *sonarqubeRemoteScopesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the sonarqube projects a connection has access to, either all of them or the ones matching a search. The results can be used to create devlake_sonarqube_connection_scope resources.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Description: "The sonarqube connection used to list the projects.",
+				Required:    true,
+			},
+			"scopes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching projects.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the project.",
+						},
+						"project_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key of the project, used as id of the devlake_sonarqube_connection_scope.",
+						},
+						"qualifier": schema.StringAttribute{
+							Computed:    true,
+							Description: "The qualifier of the project, 'TRK' for projects.",
+						},
+						"visibility": schema.StringAttribute{
+							Computed:    true,
+							Description: "The visibility of the project, either 'public' or 'private'.",
+						},
+					},
+				},
+			},
+			"search": schema.StringAttribute{
+				Description: "Search term the project names and keys are matched against. All projects are listed if not set.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sonarqubeRemoteScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sonarqubeRemoteScopesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sonarqubeRemoteScopes []client.SonarqubeConnectionScope
+	var err error
+	if state.Search.IsNull() {
+		sonarqubeRemoteScopes, err = d.client.ListSonarqubeRemoteScopes(ctx, state.ConnectionId.ValueString())
+	} else {
+		sonarqubeRemoteScopes, err = d.client.SearchSonarqubeRemoteScopes(ctx, state.ConnectionId.ValueString(), state.Search.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read devlake sonarqube remote scopes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Scopes = []sonarqubeRemoteScopeModel{}
+	for _, sonarqubeRemoteScope := range sonarqubeRemoteScopes {
+		state.Scopes = append(state.Scopes, sonarqubeRemoteScopeModel{
+			Name:       types.StringValue(sonarqubeRemoteScope.Name),
+			ProjectKey: types.StringValue(sonarqubeRemoteScope.ProjectKey),
+			Qualifier:  types.StringValue(sonarqubeRemoteScope.Qualifier),
+			Visibility: types.StringValue(sonarqubeRemoteScope.Visibility),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sonarqubeRemoteScopesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
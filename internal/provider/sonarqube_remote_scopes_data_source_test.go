@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSonarqubeRemoteScopesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing, listing remote scopes requires a reachable
+			// sonarqube so devlake reports the error of the example endpoint.
+			{
+				Config: sonarqubeConnectionConfig + `
+data "devlake_sonarqube_remote_scopes" "test" {
+  connection_id = devlake_sonarqube_connection.sonar.id
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to read devlake sonarqube remote scopes"),
+			},
+			{
+				Config: sonarqubeConnectionConfig + `
+data "devlake_sonarqube_remote_scopes" "test" {
+  connection_id = devlake_sonarqube_connection.sonar.id
+  search        = "devlake"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to read devlake sonarqube remote scopes"),
+			},
+		},
+	})
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-devlake/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webhookConnectionResource{}
+	_ resource.ResourceWithConfigure   = &webhookConnectionResource{}
+	_ resource.ResourceWithImportState = &webhookConnectionResource{}
+)
+
+// NewWebhookConnectionResource is a helper function to simplify the provider implementation.
+func NewWebhookConnectionResource() resource.Resource {
+	return &webhookConnectionResource{}
+}
+
+// webhookConnectionResource is the resource implementation.
+type webhookConnectionResource struct {
+	client *client.Client
+}
+
+// webhookConnectionResourceModel maps the resource schema data.
+type webhookConnectionResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	ApiKey           types.String   `tfsdk:"api_key"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	DeploymentsUrl   types.String   `tfsdk:"deployments_url"`
+	IncidentCloseUrl types.String   `tfsdk:"incident_close_url"`
+	IncidentsUrl     types.String   `tfsdk:"incidents_url"`
+	Name             types.String   `tfsdk:"name"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *webhookConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *webhookConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Numeric identifier for the connection. This is a string for easier resource import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection.",
+			},
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The api key generated for the connection, the push urls expect it as 'Authorization: Bearer <api_key>' header. Devlake only returns it on creation, so it is null for imported connections.",
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was created in devlake.",
+			},
+			"deployments_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The url deployments are pushed to with a POST request.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"incident_close_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The url to close an incident with a POST request, ':issueKey' needs to be replaced with the key of the incident.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"incidents_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The url incidents are pushed to with a POST request.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the webhook connection.",
+				Required:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connection was updated in devlake.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *webhookConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan webhookConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	now := time.Now().Format(time.RFC850)
+
+	// Generate API request body from plan
+	var webhookConnectionCreate = client.WebhookConnection{
+		CreatedAt: now,
+		Name:      plan.Name.ValueString(),
+		UpdatedAt: now,
+	}
+
+	// Create new webhook connection
+	webhookConnection, err := r.client.CreateWebhookConnection(ctx, webhookConnectionCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating devlake webhook connection",
+			"Could not create devlake webhook connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values, the
+	// api key is only part of this response.
+	webhookConnectionToModel(r.client, webhookConnection, &plan)
+	plan.LastUpdated = types.StringValue(now)
+	if webhookConnection.ApiKey != nil {
+		plan.ApiKey = types.StringValue(webhookConnection.ApiKey.ApiKey)
+	} else {
+		plan.ApiKey = types.StringNull()
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webhookConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state webhookConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed webhook connection value from Devlake
+	webhookConnection, err := r.client.ReadWebhookConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Removed outside of terraform, drop it from the state so it gets
+			// recreated on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read devlake webhook connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite connection with refreshed state
+	webhookConnectionToModel(r.client, webhookConnection, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update fetches the resource and sets the updated Terraform state on success.
+func (r *webhookConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan webhookConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake webhook connection",
+			"Could not update devlake webhook connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var webhookConnectionUpdate = client.WebhookConnection{
+		ID:        id,
+		CreatedAt: plan.CreatedAt.ValueString(),
+		Name:      plan.Name.ValueString(),
+		UpdatedAt: time.Now().Format(time.RFC850),
+	}
+
+	// Update existing connection
+	updatedWebhookConnection, err := r.client.UpdateWebhookConnection(ctx, plan.ID.ValueString(), webhookConnectionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating devlake webhook connection",
+			"Could not update devlake webhook connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	webhookConnectionToModel(r.client, updatedWebhookConnection, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webhookConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state webhookConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing connection
+	err := r.client.DeleteWebhookConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting devlake webhook connection",
+			"Could not delete devlake webhook connection, unexpected error: "+err.Error()+"..",
+		)
+		return
+	}
+}
+
+func (r *webhookConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// webhookConnectionToModel maps a webhook connection returned by devlake to
+// the resource model, the push urls are derived from its id.
+func webhookConnectionToModel(c *client.Client, connection *client.WebhookConnection, model *webhookConnectionResourceModel) {
+	model.ID = types.StringValue(strconv.Itoa(connection.ID))
+	model.CreatedAt = types.StringValue(connection.CreatedAt)
+	model.DeploymentsUrl = types.StringValue(c.WebhookConnectionUrl(connection.ID, "deployments"))
+	model.IncidentCloseUrl = types.StringValue(c.WebhookConnectionUrl(connection.ID, "issue/:issueKey/close"))
+	model.IncidentsUrl = types.StringValue(c.WebhookConnectionUrl(connection.ID, "issues"))
+	model.Name = types.StringValue(connection.Name)
+	model.UpdatedAt = types.StringValue(connection.UpdatedAt)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webhookConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	webhookConnectionConfig = providerConfig + `
+resource "devlake_webhook_connection" "webhook" {
+  name = "should_not_exist"
+}
+`
+)
+
+func TestAccWebhookConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: webhookConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_webhook_connection.webhook", "name", "should_not_exist"),
+					resource.TestMatchResourceAttr("devlake_webhook_connection.webhook", "deployments_url", regexp.MustCompile(`/rest/plugins/webhook/connections/\d+/deployments$`)),
+					resource.TestMatchResourceAttr("devlake_webhook_connection.webhook", "incident_close_url", regexp.MustCompile(`/rest/plugins/webhook/connections/\d+/issue/:issueKey/close$`)),
+					resource.TestMatchResourceAttr("devlake_webhook_connection.webhook", "incidents_url", regexp.MustCompile(`/rest/plugins/webhook/connections/\d+/issues$`)),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "api_key"),
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "id"),
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "last_updated"),
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "created_at"),
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "devlake_webhook_connection.webhook",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if rs, ok := s.RootModule().Resources["devlake_webhook_connection.webhook"]; ok {
+						return rs.Primary.ID, nil
+					} else {
+						return "", fmt.Errorf("Resource devlake_webhook_connection.webhook not found in state")
+					}
+				},
+				ImportStateVerify: true,
+				// The api key is only returned on creation and the
+				// last_updated attribute does exist in the devlake API, but we
+				// want the terraform state here
+				ImportStateVerifyIgnore: []string{"api_key", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devlake_webhook_connection" "webhook" {
+  name = "should_not_exist2"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devlake_webhook_connection.webhook", "name", "should_not_exist2"),
+					// The api key is kept from the creation.
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "api_key"),
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "deployments_url"),
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "id"),
+					resource.TestCheckResourceAttrSet("devlake_webhook_connection.webhook", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}